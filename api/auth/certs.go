@@ -0,0 +1,211 @@
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"gopkg.in/yaml.v3"
+)
+
+// CertIdentity 客户端证书到客户端身份的映射, Subject/CommonName/SANs 中配置的条件需全部满足, SANs 匹配任意一个即可
+type CertIdentity struct {
+	ID         string   `yaml:"id"`
+	Name       string   `yaml:"name,omitempty"`
+	Disabled   bool     `yaml:"disabled,omitempty"`
+	Subject    string   `yaml:"subject,omitempty"`
+	CommonName string   `yaml:"commonName,omitempty"`
+	SANs       []string `yaml:"sans,omitempty"`
+	Methods    []string `yaml:"methods,omitempty"`
+}
+
+// CertRegistry 证书身份映射表
+type CertRegistry struct {
+	Certs []*CertIdentity `yaml:"certs"`
+}
+
+// Matches 证书是否满足映射条件
+func (c *CertIdentity) Matches(cert *x509.Certificate) bool {
+	if len(c.Subject) > 0 && c.Subject != cert.Subject.String() {
+		return false
+	}
+	if len(c.CommonName) > 0 && c.CommonName != cert.Subject.CommonName {
+		return false
+	}
+	if len(c.SANs) > 0 {
+		sans := certSANs(cert)
+		for _, san := range c.SANs {
+			if sans[san] {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// Allows 是否允许调用指定方法, 为空表示管理接口以外的全部方法
+func (c *CertIdentity) Allows(fullMethod string) bool {
+	return AllowsMethod(c.Methods, fullMethod)
+}
+
+// Find 按配置顺序查找第一个匹配的证书身份
+func (r *CertRegistry) Find(cert *x509.Certificate) *CertIdentity {
+	for _, identity := range r.Certs {
+		if identity.Matches(cert) {
+			return identity
+		}
+	}
+	return nil
+}
+
+// LoadCertRegistry 读取证书身份映射文件, 每个映射至少需要一个匹配条件
+func LoadCertRegistry(file string) (*CertRegistry, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var registry CertRegistry
+	if err := yaml.Unmarshal(data, &registry); err != nil {
+		return nil, fmt.Errorf("failed to parse certs file %s: %v", file, err)
+	}
+	for _, identity := range registry.Certs {
+		if len(identity.ID) == 0 {
+			return nil, fmt.Errorf("certs file %s: id is required", file)
+		}
+		if len(identity.Subject) == 0 && len(identity.CommonName) == 0 && len(identity.SANs) == 0 {
+			return nil, fmt.Errorf("certs file %s: %s has no subject, commonName or sans", file, identity.ID)
+		}
+	}
+	return &registry, nil
+}
+
+// CertStore 运行时的证书身份映射表, 按文件修改时间重新加载
+type CertStore struct {
+	file     string
+	modTime  time.Time
+	registry *CertRegistry
+	mu       sync.RWMutex
+}
+
+// Certs 全局证书身份映射表, 由 InitCerts 初始化, 未初始化时不接受证书认证
+var Certs *CertStore
+
+// InitCerts 加载证书身份映射表, reload 大于0时定时检查文件变化
+func InitCerts(file string, reload time.Duration) error {
+	store := &CertStore{file: file}
+	if err := store.Reload(); err != nil {
+		return err
+	}
+	Certs = store
+
+	if reload > 0 {
+		go func() {
+			ticker := time.NewTicker(reload)
+			defer ticker.Stop()
+			for range ticker.C {
+				if err := store.Reload(); err != nil {
+					log.Printf("failed to reload certs: %v", err)
+				}
+			}
+		}()
+	}
+	return nil
+}
+
+// Reload 文件有变化时重新加载
+func (s *CertStore) Reload() error {
+	info, err := os.Stat(s.file)
+	if err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	unchanged := s.registry != nil && info.ModTime().Equal(s.modTime)
+	s.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	registry, err := LoadCertRegistry(s.file)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.registry = registry
+	s.modTime = info.ModTime()
+	s.mu.Unlock()
+	return nil
+}
+
+// Verify 将已校验的客户端证书映射为客户端身份, 并检查是否允许调用 fullMethod
+func (s *CertStore) Verify(cert *x509.Certificate, fullMethod string) (*Identity, error) {
+	if cert == nil {
+		return nil, ErrInvalidCert
+	}
+
+	s.mu.RLock()
+	registry := s.registry
+	s.mu.RUnlock()
+
+	identity := registry.Find(cert)
+	if identity == nil || identity.Disabled {
+		return nil, ErrInvalidCert
+	}
+	if !identity.Allows(fullMethod) {
+		return nil, ErrForbidden
+	}
+	return &Identity{ClientID: identity.ID, AuthType: AuthCert, Subject: cert.Subject.String()}, nil
+}
+
+// tlsStater 可提供TLS连接状态的认证信息, 如 QUIC 传输的 grpcquic.Info
+type tlsStater interface {
+	TLSState() tls.ConnectionState
+}
+
+// PeerCertificate 读取连接上已通过CA校验的客户端证书, 未提供证书或未校验时返回nil
+func PeerCertificate(p *peer.Peer) *x509.Certificate {
+	if p == nil || p.AuthInfo == nil {
+		return nil
+	}
+
+	var state tls.ConnectionState
+	switch info := p.AuthInfo.(type) {
+	case credentials.TLSInfo:
+		state = info.State
+	case tlsStater:
+		state = info.TLSState()
+	default:
+		return nil
+	}
+
+	if len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return state.VerifiedChains[0][0]
+}
+
+// certSANs 证书的全部 SAN, 包括 DNS、IP、邮箱及URI
+func certSANs(cert *x509.Certificate) map[string]bool {
+	sans := make(map[string]bool)
+	for _, name := range cert.DNSNames {
+		sans[name] = true
+	}
+	for _, ip := range cert.IPAddresses {
+		sans[ip.String()] = true
+	}
+	for _, email := range cert.EmailAddresses {
+		sans[email] = true
+	}
+	for _, uri := range cert.URIs {
+		sans[uri.String()] = true
+	}
+	return sans
+}
@@ -0,0 +1,306 @@
+// Package auth 客户端身份认证, 客户端注册表保存在YAML文件中, 密钥只保存bcrypt哈希
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"easyms-es/errno"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v3"
+)
+
+// 认证错误
+var (
+	ErrInvalidClient = errno.Unauthenticated("invalid client secret")
+	ErrInvalidToken  = errno.Unauthenticated("invalid token")
+	ErrInvalidCert   = errno.Unauthenticated("invalid client certificate")
+	ErrForbidden     = errno.PermissionDenied("permission denied")
+)
+
+// 校验通过的密钥缓存时间, 避免每个请求都计算bcrypt
+const verifyCacheTTL = 5 * time.Minute
+
+// Secret 客户端密钥, 轮换后旧密钥在 ExpiresAt 之前仍然有效
+type Secret struct {
+	Hash      string    `yaml:"hash"`
+	CreatedAt time.Time `yaml:"createdAt"`
+	ExpiresAt time.Time `yaml:"expiresAt,omitempty"`
+}
+
+// Client 客户端, Methods 为允许调用的gRPC方法, 支持 /services.PriceSearchService/* 通配, 为空表示管理接口以外的全部方法
+type Client struct {
+	ID        string    `yaml:"id"`
+	Name      string    `yaml:"name,omitempty"`
+	Disabled  bool      `yaml:"disabled,omitempty"`
+	ExpiresAt time.Time `yaml:"expiresAt,omitempty"`
+	Methods   []string  `yaml:"methods,omitempty"`
+	Secrets   []Secret  `yaml:"secrets"`
+}
+
+// Registry 客户端注册表
+type Registry struct {
+	Clients []*Client `yaml:"clients"`
+}
+
+// Active 客户端是否可用
+func (c *Client) Active(now time.Time) bool {
+	return !c.Disabled && (c.ExpiresAt.IsZero() || now.Before(c.ExpiresAt))
+}
+
+// Allows 是否允许调用指定方法
+func (c *Client) Allows(fullMethod string) bool {
+	return AllowsMethod(c.Methods, fullMethod)
+}
+
+// adminMethodPrefix 管理接口, 可写入及删除索引文档
+const adminMethodPrefix = "/services.AdminService/"
+
+// IsAdminMethod 是否为管理接口
+func IsAdminMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, adminMethodPrefix)
+}
+
+// AllowsMethod 按授权的方法列表判断是否允许调用, 为空表示管理接口以外的全部方法
+// 管理接口需显式列出 /services.AdminService/* 或具体方法, * 通配不包含管理接口
+func AllowsMethod(patterns []string, fullMethod string) bool {
+	if IsAdminMethod(fullMethod) {
+		for _, pattern := range patterns {
+			if pattern == fullMethod || pattern == adminMethodPrefix+"*" {
+				return true
+			}
+		}
+		return false
+	}
+	return len(patterns) == 0 || MatchMethod(patterns, fullMethod)
+}
+
+// MatchMethod 方法是否匹配, 支持 * 及 /services.PriceSearchService/* 通配
+func MatchMethod(patterns []string, fullMethod string) bool {
+	for _, pattern := range patterns {
+		if pattern == "*" || pattern == fullMethod {
+			return true
+		}
+		if strings.HasSuffix(pattern, "/*") && strings.HasPrefix(fullMethod, strings.TrimSuffix(pattern, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+// VerifySecret 校验密钥, 过期的密钥不再有效, 返回匹配密钥的失效时间(零值表示不失效)
+func (c *Client) VerifySecret(secret string, now time.Time) (time.Time, bool) {
+	for _, s := range c.Secrets {
+		if !s.ExpiresAt.IsZero() && !now.Before(s.ExpiresAt) {
+			continue
+		}
+		if bcrypt.CompareHashAndPassword([]byte(s.Hash), []byte(secret)) == nil {
+			return s.ExpiresAt, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Find 按ID查找客户端
+func (r *Registry) Find(id string) *Client {
+	for _, c := range r.Clients {
+		if c.ID == id {
+			return c
+		}
+	}
+	return nil
+}
+
+// Add 新增客户端并生成密钥, 返回明文密钥(只在此时可见)
+func (r *Registry) Add(client *Client) (string, error) {
+	if len(client.ID) == 0 {
+		return "", errors.New("client id is empty")
+	}
+	if r.Find(client.ID) != nil {
+		return "", fmt.Errorf("client already exists: %s", client.ID)
+	}
+	secret, hash, err := NewSecret()
+	if err != nil {
+		return "", err
+	}
+	client.Secrets = []Secret{{Hash: hash, CreatedAt: time.Now().UTC()}}
+	r.Clients = append(r.Clients, client)
+	return secret, nil
+}
+
+// Rotate 轮换密钥, 旧密钥在 overlap 之后失效, 同时清理已失效的密钥
+func (r *Registry) Rotate(id string, overlap time.Duration) (string, error) {
+	client := r.Find(id)
+	if client == nil {
+		return "", fmt.Errorf("client not found: %s", id)
+	}
+	secret, hash, err := NewSecret()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().UTC()
+	secrets := []Secret{{Hash: hash, CreatedAt: now}}
+	for _, s := range client.Secrets {
+		if !s.ExpiresAt.IsZero() && !now.Before(s.ExpiresAt) {
+			continue
+		}
+		if s.ExpiresAt.IsZero() || now.Add(overlap).Before(s.ExpiresAt) {
+			s.ExpiresAt = now.Add(overlap)
+		}
+		if overlap > 0 {
+			secrets = append(secrets, s)
+		}
+	}
+	client.Secrets = secrets
+	return secret, nil
+}
+
+// NewSecret 生成随机密钥及其bcrypt哈希
+func NewSecret() (string, string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	secret := base64.RawURLEncoding.EncodeToString(buf)
+	hash, err := bcrypt.GenerateFromPassword([]byte(secret), bcrypt.DefaultCost)
+	if err != nil {
+		return "", "", err
+	}
+	return secret, string(hash), nil
+}
+
+// LoadRegistry 读取注册表文件
+func LoadRegistry(file string) (*Registry, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var registry Registry
+	if err := yaml.Unmarshal(data, &registry); err != nil {
+		return nil, fmt.Errorf("failed to parse clients file %s: %v", file, err)
+	}
+	return &registry, nil
+}
+
+// SaveRegistry 写入注册表文件, 先写临时文件再替换
+func SaveRegistry(file string, registry *Registry) error {
+	data, err := yaml.Marshal(registry)
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(filepath.Dir(file), "."+filepath.Base(file)+".tmp")
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, file)
+}
+
+// ClientStore 运行时的客户端注册表, 按文件修改时间重新加载
+type ClientStore struct {
+	file     string
+	modTime  time.Time
+	registry *Registry
+	verified map[[32]byte]time.Time // 校验通过的 ID+密钥 摘要及缓存到期时间
+	mu       sync.RWMutex
+}
+
+// Clients 全局客户端注册表, 由 InitClients 初始化
+var Clients *ClientStore
+
+// InitClients 加载客户端注册表, reload 大于0时定时检查文件变化
+func InitClients(file string, reload time.Duration) error {
+	store := &ClientStore{file: file}
+	if err := store.Reload(); err != nil {
+		return err
+	}
+	Clients = store
+
+	if reload > 0 {
+		go func() {
+			ticker := time.NewTicker(reload)
+			defer ticker.Stop()
+			for range ticker.C {
+				if err := store.Reload(); err != nil {
+					log.Printf("failed to reload clients: %v", err)
+				}
+			}
+		}()
+	}
+	return nil
+}
+
+// Reload 文件有变化时重新加载, 同时清空校验缓存
+func (s *ClientStore) Reload() error {
+	info, err := os.Stat(s.file)
+	if err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	unchanged := s.registry != nil && info.ModTime().Equal(s.modTime)
+	s.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	registry, err := LoadRegistry(s.file)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.registry = registry
+	s.modTime = info.ModTime()
+	s.verified = make(map[[32]byte]time.Time)
+	s.mu.Unlock()
+	return nil
+}
+
+// Verify 校验客户端ID及密钥, 并检查是否允许调用 fullMethod
+func (s *ClientStore) Verify(clientID string, clientSecret string, fullMethod string) (*Client, error) {
+	now := time.Now()
+
+	s.mu.RLock()
+	registry := s.registry
+	s.mu.RUnlock()
+
+	client := registry.Find(clientID)
+	if client == nil || len(clientSecret) == 0 || !client.Active(now) {
+		return nil, ErrInvalidClient
+	}
+
+	key := sha256.Sum256([]byte(clientID + "\x00" + clientSecret))
+	s.mu.RLock()
+	until, ok := s.verified[key]
+	s.mu.RUnlock()
+
+	if !ok || now.After(until) {
+		expiresAt, valid := client.VerifySecret(clientSecret, now)
+		if !valid {
+			return nil, ErrInvalidClient
+		}
+		// 缓存不超过密钥的失效时间
+		until = now.Add(verifyCacheTTL)
+		if !expiresAt.IsZero() && expiresAt.Before(until) {
+			until = expiresAt
+		}
+		s.mu.Lock()
+		s.verified[key] = until
+		s.mu.Unlock()
+	}
+
+	if !client.Allows(fullMethod) {
+		return nil, ErrForbidden
+	}
+	return client, nil
+}
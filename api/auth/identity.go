@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// 认证方式
+const (
+	AuthSecret = "secret" // Client-ID + Client-Secret
+	AuthJWT    = "jwt"    // authorization: Bearer
+	AuthCert   = "cert"   // 客户端证书
+)
+
+// Identity 认证后的调用方身份, ClientID 写入访问日志
+type Identity struct {
+	ClientID string
+	Scopes   []string
+	AuthType string
+	Subject  string // 证书认证时为证书的 Subject
+}
+
+type identityKey struct{}
+
+// WithIdentity 将调用方身份写入上下文
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext 从上下文中获取调用方身份
+func IdentityFromContext(ctx context.Context) *Identity {
+	identity, _ := ctx.Value(identityKey{}).(*Identity)
+	return identity
+}
+
+// Mode 监听器的认证方式
+type Mode string
+
+const (
+	ModeSecret Mode = "secret" // 只接受客户端授权码
+	ModeJWT    Mode = "jwt"    // 只接受 Bearer 令牌
+	ModeCert   Mode = "cert"   // 只接受客户端证书
+	ModeAny    Mode = "any"    // 依次按 Bearer 令牌、授权码、客户端证书校验, 以请求携带的凭证为准
+)
+
+// ParseMode 解析认证方式配置, 为空时为 secret
+func ParseMode(mode string) (Mode, error) {
+	switch m := Mode(strings.ToLower(strings.TrimSpace(mode))); m {
+	case "":
+		return ModeSecret, nil
+	case ModeSecret, ModeJWT, ModeCert, ModeAny:
+		return m, nil
+	default:
+		return "", fmt.Errorf("auth mode is error: %s", mode)
+	}
+}
+
+// Authenticate 按监听器的认证方式校验metadata中的凭证或客户端证书, 并检查是否允许调用 fullMethod
+func Authenticate(md metadata.MD, cert *x509.Certificate, fullMethod string, mode Mode) (*Identity, error) {
+	token, hasToken := bearerToken(md)
+
+	if mode == ModeJWT || (mode == ModeAny && hasToken) {
+		if !hasToken || Tokens == nil {
+			return nil, ErrInvalidToken
+		}
+		return Tokens.Verify(token, fullMethod)
+	}
+
+	// any 模式下未携带授权码时使用客户端证书
+	if mode == ModeCert || (mode == ModeAny && len(firstValue(md, "client-id")) == 0 && cert != nil) {
+		if Certs == nil {
+			return nil, ErrInvalidCert
+		}
+		return Certs.Verify(cert, fullMethod)
+	}
+
+	if Clients == nil {
+		return nil, ErrInvalidClient
+	}
+	client, err := Clients.Verify(firstValue(md, "client-id"), firstValue(md, "client-secret"), fullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return &Identity{ClientID: client.ID, AuthType: AuthSecret}, nil
+}
+
+// bearerToken 读取 authorization: Bearer 令牌
+func bearerToken(md metadata.MD) (string, bool) {
+	value := firstValue(md, "authorization")
+	if len(value) < 7 || !strings.EqualFold(value[:7], "bearer ") {
+		return "", false
+	}
+	token := strings.TrimSpace(value[7:])
+	return token, len(token) > 0
+}
+
+func firstValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
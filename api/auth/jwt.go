@@ -0,0 +1,268 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWTConfig Bearer 令牌校验配置
+type JWTConfig struct {
+	JWKSFile     string              // 本地 JWKS 文件
+	Issuer       string              // iss, 为空不校验
+	Audience     string              // aud, 为空不校验
+	ClientClaim  string              // 作为客户端ID的声明, 默认 client_id, 不存在时依次取 azp、sub
+	Leeway       time.Duration       // 时间校验的容差
+	Reload       time.Duration       // JWKS 文件变化检查间隔
+	ScopeMethods map[string][]string // scope 允许调用的方法, 为空表示不按 scope 限制(管理接口除外)
+}
+
+// TokenVerifier JWT 校验器, 支持 RS256 及 ES256
+type TokenVerifier struct {
+	config  JWTConfig
+	modTime time.Time
+	keys    map[string]crypto.PublicKey // kid:公钥
+	mu      sync.RWMutex
+}
+
+// Tokens 全局令牌校验器, 由 InitJWT 初始化, 未初始化时不接受令牌
+var Tokens *TokenVerifier
+
+// InitJWT 加载 JWKS, Reload 大于0时定时检查文件变化
+func InitJWT(c JWTConfig) error {
+	if len(c.ClientClaim) == 0 {
+		c.ClientClaim = "client_id"
+	}
+	verifier := &TokenVerifier{config: c}
+	if err := verifier.Reload(); err != nil {
+		return err
+	}
+	Tokens = verifier
+
+	if c.Reload > 0 {
+		go func() {
+			ticker := time.NewTicker(c.Reload)
+			defer ticker.Stop()
+			for range ticker.C {
+				if err := verifier.Reload(); err != nil {
+					log.Printf("failed to reload jwks: %v", err)
+				}
+			}
+		}()
+	}
+	return nil
+}
+
+// Reload 文件有变化时重新加载 JWKS, 解析失败时保留原有的公钥
+func (v *TokenVerifier) Reload() error {
+	info, err := os.Stat(v.config.JWKSFile)
+	if err != nil {
+		return err
+	}
+
+	v.mu.RLock()
+	unchanged := v.keys != nil && info.ModTime().Equal(v.modTime)
+	v.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	data, err := os.ReadFile(v.config.JWKSFile)
+	if err != nil {
+		return err
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse jwks %s: %v", v.config.JWKSFile, err)
+	}
+
+	v.mu.Lock()
+	v.keys = keys
+	v.modTime = info.ModTime()
+	v.mu.Unlock()
+	return nil
+}
+
+// Verify 校验令牌签名、iss、aud 及有效期, 并按 scope 检查是否允许调用 fullMethod
+// 签名及声明由 golang-jwt 校验, 只接受 RS256、ES256, exp 必填
+func (v *TokenVerifier) Verify(token string, fullMethod string) (*Identity, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "ES256"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(v.config.Leeway),
+	}
+	if len(v.config.Issuer) > 0 {
+		options = append(options, jwt.WithIssuer(v.config.Issuer))
+	}
+	if len(v.config.Audience) > 0 {
+		options = append(options, jwt.WithAudience(v.config.Audience))
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.NewParser(options...).ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, ok := v.key(kid, t.Method.Alg())
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+		return key, nil
+	})
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	identity := &Identity{
+		ClientID: clientIDClaim(claims, v.config.ClientClaim),
+		Scopes:   scopeClaim(claims),
+		AuthType: AuthJWT,
+	}
+	if len(identity.ClientID) == 0 {
+		return nil, ErrInvalidToken
+	}
+	if !v.allows(identity.Scopes, fullMethod) {
+		return nil, ErrForbidden
+	}
+	return identity, nil
+}
+
+// key 按 kid 查找公钥, 令牌未指定 kid 时只有一个同类型公钥才可使用
+func (v *TokenVerifier) key(kid string, alg string) (crypto.PublicKey, bool) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	if len(kid) > 0 {
+		key, ok := v.keys[kid]
+		return key, ok && keyMatchesAlg(key, alg)
+	}
+
+	var found crypto.PublicKey
+	for _, key := range v.keys {
+		if keyMatchesAlg(key, alg) {
+			if found != nil {
+				return nil, false
+			}
+			found = key
+		}
+	}
+	return found, found != nil
+}
+
+// allows 令牌的 scope 是否允许调用方法, 管理接口需有 scope 显式授权
+func (v *TokenVerifier) allows(scopes []string, fullMethod string) bool {
+	if len(v.config.ScopeMethods) == 0 {
+		return !IsAdminMethod(fullMethod)
+	}
+	for _, scope := range scopes {
+		if methods := v.config.ScopeMethods[scope]; len(methods) > 0 && AllowsMethod(methods, fullMethod) {
+			return true
+		}
+	}
+	return false
+}
+
+func keyMatchesAlg(key crypto.PublicKey, alg string) bool {
+	switch key.(type) {
+	case *rsa.PublicKey:
+		return alg == "RS256"
+	case *ecdsa.PublicKey:
+		return alg == "ES256"
+	default:
+		return false
+	}
+}
+
+// parseJWKS 解析 JWKS, 只保留签名用的 RSA 及 P-256 公钥
+func parseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			Crv string `json:"crv"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey)
+	for i, k := range jwks.Keys {
+		if len(k.Use) > 0 && k.Use != "sig" {
+			continue
+		}
+		kid := k.Kid
+		if len(kid) == 0 {
+			kid = fmt.Sprintf("#%d", i)
+		}
+		switch k.Kty {
+		case "RSA":
+			n, err1 := base64.RawURLEncoding.DecodeString(k.N)
+			e, err2 := base64.RawURLEncoding.DecodeString(k.E)
+			if err1 != nil || err2 != nil || len(e) > 4 {
+				return nil, fmt.Errorf("rsa key is error: %s", kid)
+			}
+			keys[kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		case "EC":
+			if k.Crv != "P-256" {
+				continue
+			}
+			x, err1 := base64.RawURLEncoding.DecodeString(k.X)
+			y, err2 := base64.RawURLEncoding.DecodeString(k.Y)
+			if err1 != nil || err2 != nil {
+				return nil, fmt.Errorf("ec key is error: %s", kid)
+			}
+			pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+			if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+				return nil, fmt.Errorf("ec key is not on curve: %s", kid)
+			}
+			keys[kid] = pub
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no signing keys")
+	}
+	return keys, nil
+}
+
+// clientIDClaim 客户端ID, 依次取配置的声明、azp、sub
+func clientIDClaim(claims map[string]any, claim string) string {
+	for _, name := range []string{claim, "azp", "sub"} {
+		if value, ok := claims[name].(string); ok && len(value) > 0 {
+			return value
+		}
+	}
+	return ""
+}
+
+// scopeClaim scope 为空格分隔的字符串, scp 为数组
+func scopeClaim(claims map[string]any) []string {
+	if scope, ok := claims["scope"].(string); ok {
+		return strings.Fields(scope)
+	}
+	var scopes []string
+	if scp, ok := claims["scp"].([]any); ok {
+		for _, s := range scp {
+			if value, ok := s.(string); ok {
+				scopes = append(scopes, value)
+			}
+		}
+	}
+	return scopes
+}
@@ -0,0 +1,368 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testIssuer   = "https://auth.easy.dev"
+	testAudience = "easyes"
+	testMethod   = "/services.ProductsSearchService/SearchProducts"
+)
+
+// testKeys 测试用的签名密钥, rsa1/rsa2/ec1 对应 JWKS 中的 kid
+type testKeys struct {
+	rsa1 *rsa.PrivateKey
+	rsa2 *rsa.PrivateKey
+	ec1  *ecdsa.PrivateKey
+}
+
+func newTestKeys(t *testing.T) testKeys {
+	t.Helper()
+	rsa1, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsa2, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ec1, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testKeys{rsa1: rsa1, rsa2: rsa2, ec1: ec1}
+}
+
+// jwks 按 JWKS 格式输出公钥, 经 parseJWKS 解析
+func (k testKeys) jwks(t *testing.T, kids ...string) map[string]crypto.PublicKey {
+	t.Helper()
+	encode := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	rsaJWK := func(kid string, key *rsa.PrivateKey) map[string]string {
+		return map[string]string{"kty": "RSA", "kid": kid, "use": "sig", "n": encode(key.N.Bytes()), "e": encode(big.NewInt(int64(key.E)).Bytes())}
+	}
+	all := map[string]map[string]string{
+		"rsa1": rsaJWK("rsa1", k.rsa1),
+		"rsa2": rsaJWK("rsa2", k.rsa2),
+		"ec1":  {"kty": "EC", "kid": "ec1", "crv": "P-256", "x": encode(k.ec1.X.FillBytes(make([]byte, 32))), "y": encode(k.ec1.Y.FillBytes(make([]byte, 32)))},
+	}
+	var keys []map[string]string
+	for _, kid := range kids {
+		keys = append(keys, all[kid])
+	}
+	data, err := json.Marshal(map[string]any{"keys": keys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := parseJWKS(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+// sign 签发令牌, kid 为空时不设置 kid
+func sign(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if len(kid) > 0 {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func validClaims(now time.Time) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":       testIssuer,
+		"aud":       testAudience,
+		"client_id": "easy",
+		"scope":     "search",
+		"exp":       now.Add(time.Hour).Unix(),
+		"nbf":       now.Add(-time.Minute).Unix(),
+	}
+}
+
+func with(claims jwt.MapClaims, key string, value any) jwt.MapClaims {
+	c := jwt.MapClaims{}
+	for k, v := range claims {
+		c[k] = v
+	}
+	if value == nil {
+		delete(c, key)
+	} else {
+		c[key] = value
+	}
+	return c
+}
+
+// tamper 替换载荷但保留原签名
+func tamper(t *testing.T, token string, claims jwt.MapClaims) string {
+	t.Helper()
+	parts := strings.Split(token, ".")
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts[1] = base64.RawURLEncoding.EncodeToString(payload)
+	return strings.Join(parts, ".")
+}
+
+func TestTokenVerifierVerify(t *testing.T) {
+	keys := newTestKeys(t)
+	now := time.Now()
+	claims := validClaims(now)
+	scopes := map[string][]string{
+		"search": {"/services.ProductsSearchService/*"},
+		"admin":  {"/services.AdminService/*"},
+	}
+
+	tests := []struct {
+		name    string
+		kids    []string // JWKS 中的公钥
+		token   string
+		method  string
+		wantErr error
+	}{
+		{
+			name:  "rs256 with kid",
+			kids:  []string{"rsa1", "rsa2", "ec1"},
+			token: sign(t, jwt.SigningMethodRS256, keys.rsa1, "rsa1", claims),
+		},
+		{
+			name:  "es256 with kid",
+			kids:  []string{"rsa1", "ec1"},
+			token: sign(t, jwt.SigningMethodES256, keys.ec1, "ec1", claims),
+		},
+		{
+			name:    "signed by another key with same kid",
+			kids:    []string{"rsa1", "rsa2"},
+			token:   sign(t, jwt.SigningMethodRS256, keys.rsa2, "rsa1", claims),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "es256 header with rsa kid",
+			kids:    []string{"rsa1", "ec1"},
+			token:   sign(t, jwt.SigningMethodES256, keys.ec1, "rsa1", claims),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "rs256 header with ec kid",
+			kids:    []string{"rsa1", "ec1"},
+			token:   sign(t, jwt.SigningMethodRS256, keys.rsa1, "ec1", claims),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "hs256 keyed with rsa public key",
+			kids:    []string{"rsa1"},
+			token:   sign(t, jwt.SigningMethodHS256, keys.rsa1.N.Bytes(), "rsa1", claims),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "alg none",
+			kids:    []string{"rsa1"},
+			token:   sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "rsa1", claims),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:  "missing kid with a single key of the alg",
+			kids:  []string{"rsa1", "ec1"},
+			token: sign(t, jwt.SigningMethodRS256, keys.rsa1, "", claims),
+		},
+		{
+			name:    "missing kid with several keys of the alg",
+			kids:    []string{"rsa1", "rsa2"},
+			token:   sign(t, jwt.SigningMethodRS256, keys.rsa1, "", claims),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "unknown kid",
+			kids:    []string{"rsa1"},
+			token:   sign(t, jwt.SigningMethodRS256, keys.rsa1, "rsa9", claims),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:  "expired within leeway",
+			kids:  []string{"rsa1"},
+			token: sign(t, jwt.SigningMethodRS256, keys.rsa1, "rsa1", with(claims, "exp", now.Add(-10*time.Second).Unix())),
+		},
+		{
+			name:    "expired beyond leeway",
+			kids:    []string{"rsa1"},
+			token:   sign(t, jwt.SigningMethodRS256, keys.rsa1, "rsa1", with(claims, "exp", now.Add(-time.Minute).Unix())),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "missing exp",
+			kids:    []string{"rsa1"},
+			token:   sign(t, jwt.SigningMethodRS256, keys.rsa1, "rsa1", with(claims, "exp", nil)),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:  "not yet valid within leeway",
+			kids:  []string{"rsa1"},
+			token: sign(t, jwt.SigningMethodRS256, keys.rsa1, "rsa1", with(claims, "nbf", now.Add(10*time.Second).Unix())),
+		},
+		{
+			name:    "not yet valid beyond leeway",
+			kids:    []string{"rsa1"},
+			token:   sign(t, jwt.SigningMethodRS256, keys.rsa1, "rsa1", with(claims, "nbf", now.Add(time.Minute).Unix())),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:  "aud array containing audience",
+			kids:  []string{"rsa1"},
+			token: sign(t, jwt.SigningMethodRS256, keys.rsa1, "rsa1", with(claims, "aud", []string{"other", testAudience})),
+		},
+		{
+			name:    "aud array without audience",
+			kids:    []string{"rsa1"},
+			token:   sign(t, jwt.SigningMethodRS256, keys.rsa1, "rsa1", with(claims, "aud", []string{"other"})),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "wrong issuer",
+			kids:    []string{"rsa1"},
+			token:   sign(t, jwt.SigningMethodRS256, keys.rsa1, "rsa1", with(claims, "iss", "https://evil.dev")),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "tampered payload",
+			kids:    []string{"rsa1"},
+			token:   tamper(t, sign(t, jwt.SigningMethodRS256, keys.rsa1, "rsa1", claims), with(claims, "scope", "search admin")),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "tampered es256 payload",
+			kids:    []string{"ec1"},
+			token:   tamper(t, sign(t, jwt.SigningMethodES256, keys.ec1, "ec1", claims), with(claims, "client_id", "other")),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "missing client id",
+			kids:    []string{"rsa1"},
+			token:   sign(t, jwt.SigningMethodRS256, keys.rsa1, "rsa1", with(claims, "client_id", nil)),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "admin method without admin scope",
+			kids:    []string{"rsa1"},
+			token:   sign(t, jwt.SigningMethodRS256, keys.rsa1, "rsa1", claims),
+			method:  "/services.AdminService/UpsertProducts",
+			wantErr: ErrForbidden,
+		},
+		{
+			name:   "admin method with admin scope",
+			kids:   []string{"rsa1"},
+			token:  sign(t, jwt.SigningMethodRS256, keys.rsa1, "rsa1", with(claims, "scope", "admin")),
+			method: "/services.AdminService/UpsertProducts",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := &TokenVerifier{
+				config: JWTConfig{
+					Issuer:       testIssuer,
+					Audience:     testAudience,
+					ClientClaim:  "client_id",
+					Leeway:       30 * time.Second,
+					ScopeMethods: scopes,
+				},
+				keys: keys.jwks(t, tt.kids...),
+			}
+			method := tt.method
+			if len(method) == 0 {
+				method = testMethod
+			}
+
+			identity, err := verifier.Verify(tt.token, method)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if identity.ClientID != "easy" || identity.AuthType != AuthJWT {
+				t.Fatalf("Verify() identity = %+v", identity)
+			}
+		})
+	}
+}
+
+func TestParseJWKS(t *testing.T) {
+	tests := []struct {
+		name     string
+		jwks     string
+		wantKids []string
+		wantErr  bool
+	}{
+		{
+			name:     "skips encryption keys and other curves",
+			jwks:     `{"keys":[{"kty":"RSA","kid":"a","use":"sig","n":"AQAB","e":"AQAB"},{"kty":"RSA","kid":"b","use":"enc","n":"AQAB","e":"AQAB"},{"kty":"EC","kid":"c","crv":"P-384","x":"AQ","y":"AQ"}]}`,
+			wantKids: []string{"a"},
+		},
+		{
+			name:    "ec point not on curve",
+			jwks:    `{"keys":[{"kty":"EC","kid":"c","crv":"P-256","x":"AQ","y":"AQ"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "rsa exponent too large",
+			jwks:    `{"keys":[{"kty":"RSA","kid":"a","n":"AQAB","e":"AQAAAAAB"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "no signing keys",
+			jwks:    `{"keys":[]}`,
+			wantErr: true,
+		},
+		{
+			name:    "malformed json",
+			jwks:    `{"keys":`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := parseJWKS([]byte(tt.jwks))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseJWKS() keys = %v, want error", keys)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseJWKS() error = %v", err)
+			}
+			if len(keys) != len(tt.wantKids) {
+				t.Fatalf("parseJWKS() keys = %v, want %v", keys, tt.wantKids)
+			}
+			for _, kid := range tt.wantKids {
+				if _, ok := keys[kid]; !ok {
+					t.Fatalf("parseJWKS() missing kid %s", kid)
+				}
+			}
+		})
+	}
+}
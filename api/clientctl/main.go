@@ -0,0 +1,166 @@
+// clientctl 客户端注册表管理工具, 新增、轮换、启用/停用客户端
+//
+//	clientctl -file /conf/api/clients.yaml add -id easy -name 商城 -methods "/services.ProductsSearchService/*" -expires 2026-12-31
+//	clientctl -file /conf/api/clients.yaml rotate -id easy -overlap 24h
+//	clientctl -file /conf/api/clients.yaml disable -id easy
+//	clientctl -file /conf/api/clients.yaml list
+package main
+
+import (
+	"easyms-es/api/auth"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+func main() {
+	file := flag.String("file", "/conf/api/clients.yaml", "客户端注册表文件")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() < 1 {
+		usage()
+		os.Exit(2)
+	}
+
+	registry, err := auth.LoadRegistry(*file)
+	if errors.Is(err, os.ErrNotExist) && flag.Arg(0) == "add" {
+		registry, err = &auth.Registry{}, nil
+	}
+	if err != nil {
+		log.Fatalf("failed to load clients: %v", err)
+	}
+
+	args := flag.Args()[1:]
+	changed := true
+	switch flag.Arg(0) {
+	case "add":
+		err = add(registry, args)
+	case "rotate":
+		err = rotate(registry, args)
+	case "enable":
+		err = setDisabled(registry, args, false)
+	case "disable":
+		err = setDisabled(registry, args, true)
+	case "remove":
+		err = remove(registry, args)
+	case "list":
+		list(registry)
+		changed = false
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if changed {
+		if err := auth.SaveRegistry(*file, registry); err != nil {
+			log.Fatalf("failed to save clients: %v", err)
+		}
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: clientctl [-file clients.yaml] add|rotate|enable|disable|remove|list [flags]\n")
+	flag.PrintDefaults()
+}
+
+// add 新增客户端, 密钥只输出一次
+func add(registry *auth.Registry, args []string) error {
+	fs := flag.NewFlagSet("add", flag.ExitOnError)
+	id := fs.String("id", "", "客户端ID")
+	name := fs.String("name", "", "客户端名称")
+	methods := fs.String("methods", "", "允许调用的方法, 逗号分隔, 支持 /services.PriceSearchService/* 通配, 不传表示管理接口以外的全部方法, 管理接口需显式列出 /services.AdminService/*")
+	expires := fs.String("expires", "", "客户端失效日期 2006-01-02, 不传表示不失效")
+	_ = fs.Parse(args)
+
+	client := &auth.Client{ID: *id, Name: *name}
+	for _, method := range strings.Split(*methods, ",") {
+		if method = strings.TrimSpace(method); len(method) > 0 {
+			client.Methods = append(client.Methods, method)
+		}
+	}
+	if len(*expires) > 0 {
+		expiresAt, err := time.Parse(time.DateOnly, *expires)
+		if err != nil {
+			return fmt.Errorf("expires is error: %s", *expires)
+		}
+		client.ExpiresAt = expiresAt.UTC()
+	}
+
+	secret, err := registry.Add(client)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Client-ID: %s\nClient-Secret: %s\n", client.ID, secret)
+	return nil
+}
+
+// rotate 轮换密钥, 旧密钥在 overlap 内仍然有效
+func rotate(registry *auth.Registry, args []string) error {
+	fs := flag.NewFlagSet("rotate", flag.ExitOnError)
+	id := fs.String("id", "", "客户端ID")
+	overlap := fs.Duration("overlap", 24*time.Hour, "旧密钥的保留时间, 0 表示立即失效")
+	_ = fs.Parse(args)
+
+	secret, err := registry.Rotate(*id, *overlap)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Client-ID: %s\nClient-Secret: %s\nold secrets expire at: %s\n", *id, secret, time.Now().Add(*overlap).Format(time.RFC3339))
+	return nil
+}
+
+// setDisabled 启用或停用客户端
+func setDisabled(registry *auth.Registry, args []string, disabled bool) error {
+	fs := flag.NewFlagSet("disable", flag.ExitOnError)
+	id := fs.String("id", "", "客户端ID")
+	_ = fs.Parse(args)
+
+	client := registry.Find(*id)
+	if client == nil {
+		return fmt.Errorf("client not found: %s", *id)
+	}
+	client.Disabled = disabled
+	return nil
+}
+
+// remove 删除客户端
+func remove(registry *auth.Registry, args []string) error {
+	fs := flag.NewFlagSet("remove", flag.ExitOnError)
+	id := fs.String("id", "", "客户端ID")
+	_ = fs.Parse(args)
+
+	for i, client := range registry.Clients {
+		if client.ID == *id {
+			registry.Clients = append(registry.Clients[:i], registry.Clients[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("client not found: %s", *id)
+}
+
+// list 输出客户端列表, 不输出密钥
+func list(registry *auth.Registry) {
+	now := time.Now()
+	for _, client := range registry.Clients {
+		status := "active"
+		if !client.Active(now) {
+			status = "inactive"
+		}
+		expires := "-"
+		if !client.ExpiresAt.IsZero() {
+			expires = client.ExpiresAt.Format(time.DateOnly)
+		}
+		methods := "*"
+		if len(client.Methods) > 0 {
+			methods = strings.Join(client.Methods, ",")
+		}
+		fmt.Printf("%s\t%s\t%s\texpires:%s\tsecrets:%d\tmethods:%s\n", client.ID, client.Name, status, expires, len(client.Secrets), methods)
+	}
+}
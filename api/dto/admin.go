@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"easyms-es/errno"
+	"easyms-es/model"
+	ms "easyms-es/protos/messages"
+	"easyms-es/service/models"
+	"time"
+)
+
+// MapperToProductSources 将 ms.ProductDoc 转换成 model.ProductSource
+func MapperToProductSources(docs []*ms.ProductDoc) []model.ProductSource {
+	var sources []model.ProductSource
+	for _, doc := range docs {
+		sources = append(sources, model.ProductSource{
+			PID:         int(doc.PID),
+			ProductName: doc.ProductName,
+			Brand:       doc.Brand,
+			BrandID:     int(doc.BrandID),
+			CategoryID:  int(doc.CategoryID),
+			ParentID:    int(doc.ParentID),
+		})
+	}
+	return sources
+}
+
+// MapperToPriceSources 将 ms.PriceDoc 转换成 model.PriceSource, UpdateTime 未传时取当前时间
+func MapperToPriceSources(docs []*ms.PriceDoc) ([]model.PriceSource, error) {
+	var sources []model.PriceSource
+	for _, doc := range docs {
+		source := model.PriceSource{
+			SID:                   int(doc.SID),
+			PID:                   int(doc.PID),
+			DistributorType:       int(doc.DistributorType),
+			DistributorID:         int(doc.DistributorID),
+			Distributor:           doc.Distributor,
+			DistributorProductUrl: doc.DistributorProductUrl,
+			ProductName:           doc.ProductName,
+			Brand:                 doc.Brand,
+			StockNum:              int(doc.StockNum),
+			Currency:              doc.Currency,
+			MOQ:                   int(doc.MOQ),
+			Multiples:             int(doc.Multiples),
+			UpdateTime:            time.Now().UTC(),
+		}
+		if len(doc.UpdateTime) > 0 {
+			updateTime, err := time.Parse(time.RFC3339, doc.UpdateTime)
+			if err != nil {
+				return nil, errno.InvalidParam("UpdateTime is error: %s", doc.UpdateTime)
+			}
+			source.UpdateTime = updateTime
+		}
+		for _, step := range doc.StepPrices {
+			source.StepPrices = append(source.StepPrices, model.StepPrice{
+				Qty:   int(step.Qty),
+				Price: step.Price,
+			})
+		}
+		sources = append(sources, source)
+	}
+	return sources, nil
+}
+
+// MapperToWriteResult 将 models.WriteResult 转换成 ms.WriteResult
+func MapperToWriteResult(result *models.WriteResult) *ms.WriteResult {
+	var pbResult ms.WriteResult
+	pbResult.Total = result.Total
+	pbResult.Succeeded = result.Succeeded
+	pbResult.Failed = result.Failed
+
+	for _, item := range result.Items {
+		pbResult.Items = append(pbResult.Items, &ms.WriteItemResult{
+			ID:     item.ID,
+			Result: item.Result,
+			Error:  item.Error,
+		})
+	}
+
+	return &pbResult
+}
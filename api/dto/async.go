@@ -0,0 +1,28 @@
+package dto
+
+import (
+	ms "easyms-es/protos/messages"
+	"easyms-es/service/models"
+)
+
+// MapperToAsyncSearchStatus 将 models.AsyncSearchResult 转换成 ms.AsyncSearchStatus
+func MapperToAsyncSearchStatus(result *models.AsyncSearchResult) (*ms.AsyncSearchStatus, error) {
+	var pbStatus ms.AsyncSearchStatus
+	pbStatus.ID = result.ID
+	pbStatus.IsRunning = result.IsRunning
+	pbStatus.IsPartial = result.IsPartial
+	pbStatus.StartTime = result.StartTime
+	pbStatus.ExpirationTime = result.ExpirationTime
+
+	products, err := MapperToSearchProductsResult(&result.Products)
+	if err != nil {
+		return nil, err
+	}
+	pbStatus.Products = products
+
+	if result.Facets != nil {
+		pbStatus.Facets = MapperToFacetsResult(result.Facets)
+	}
+
+	return &pbStatus, nil
+}
@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"easyms-es/model"
+	ms "easyms-es/protos/messages"
+	"easyms-es/service/models"
+)
+
+// MapperToBomMatchParam 将 ms.MatchBOMParam 转换成 model.BomMatchParam
+func MapperToBomMatchParam(req *ms.MatchBOMParam) model.BomMatchParam {
+	param := model.BomMatchParam{
+		TopOffers:      req.TopOffers,
+		TargetCurrency: req.TargetCurrency,
+	}
+	for _, row := range req.Rows {
+		param.Rows = append(param.Rows, model.BomRow{
+			PartNumber:   row.PartNumber,
+			Manufacturer: row.Manufacturer,
+			Quantity:     row.Quantity,
+		})
+	}
+	return param
+}
+
+// MapperToMatchBOMResult 将 models.BomMatchResult 转换成 ms.MatchBOMResult
+func MapperToMatchBOMResult(result *models.BomMatchResult) *ms.MatchBOMResult {
+	var pbResult ms.MatchBOMResult
+	pbResult.Total = result.Total
+	pbResult.Matched = result.Matched
+	pbResult.TargetCurrency = result.TargetCurrency
+
+	for _, line := range result.Lines {
+		pbLine := ms.BomLineResult{
+			Line: int32(line.Line),
+			Row: &ms.BomRow{
+				PartNumber:   line.Row.PartNumber,
+				Manufacturer: line.Row.Manufacturer,
+				Quantity:     line.Row.Quantity,
+			},
+			StandPartNumber: line.StandPartNumber,
+			MatchType:       line.MatchType,
+			Confidence:      float32(line.Confidence),
+			Error:           line.Error,
+		}
+		if line.Product.PID > 0 {
+			pbLine.Product = MapperToESProduct(line.Product)
+		}
+		for _, quote := range line.Offers {
+			pbLine.Offers = append(pbLine.Offers, mapperToPriceQuote(quote))
+		}
+		pbResult.Lines = append(pbResult.Lines, &pbLine)
+	}
+
+	return &pbResult
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	ms "easyms-es/protos/messages"
+	"easyms-es/service/models"
+)
+
+// MapperToFacetsResult 将 models.SearchFacetResult 转换成 ms.FacetsResult
+func MapperToFacetsResult(facets *models.SearchFacetResult) *ms.FacetsResult {
+	return &ms.FacetsResult{
+		Categories:      mapperToFacetBuckets(facets.Categories),
+		Brands:          mapperToFacetBuckets(facets.Brands),
+		Distributors:    mapperToFacetBuckets(facets.Distributors),
+		AttributeNames:  mapperToFacetBuckets(facets.AttributeNames),
+		AttributeValues: mapperToFacetBuckets(facets.AttributeValues),
+		IsCache:         facets.IsCache,
+	}
+}
+
+// mapperToFacetBuckets 分面桶递归转换
+func mapperToFacetBuckets(buckets []models.FacetBucket) []*ms.FacetBucket {
+	var pbBuckets []*ms.FacetBucket
+	for _, bucket := range buckets {
+		pbBuckets = append(pbBuckets, &ms.FacetBucket{
+			Key:      bucket.Key,
+			Name:     bucket.Name,
+			Group:    bucket.Group,
+			Count:    int32(bucket.Count),
+			Children: mapperToFacetBuckets(bucket.Children),
+		})
+	}
+	return pbBuckets
+}
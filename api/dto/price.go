@@ -1,12 +1,31 @@
 package dto
 
 import (
+	"easyms-es/model"
 	ms "easyms-es/protos/messages"
 	"easyms-es/service/models"
 	"easyms-es/utility"
 	"strconv"
 )
 
+// MapperToPriceSearchParam 将 ms.PriceSearchParam 转换成 model.PriceSearchParam
+func MapperToPriceSearchParam(req *ms.PriceSearchParam) model.PriceSearchParam {
+	return model.PriceSearchParam{
+		PID:             req.PID,
+		InStock:         req.InStock,
+		AuthorizedOnly:  req.AuthorizedOnly,
+		DistributorType: req.DistributorType,
+		DistributorIDs:  req.DistributorIDs,
+		Currencies:      req.Currencies,
+		MinStock:        req.MinStock,
+		SortType:        model.PriceSortType(req.SortType),
+		PriceTier:       req.PriceTier,
+		TargetCurrency:  req.TargetCurrency,
+		Size:            req.Size,
+		From:            req.From,
+	}
+}
+
 func MapperToSearchPricesResult(prices *models.SearchPriceResult) (*ms.SearchPricesResult, error) {
 	var pbPrices ms.SearchPricesResult
 	pbPrices.Total = prices.Total
@@ -17,72 +36,189 @@ func MapperToSearchPricesResult(prices *models.SearchPriceResult) (*ms.SearchPri
 		pbPrices.PID = int32(prices.Results[0].PID)
 	}
 
+	pbPrices.TargetCurrency = prices.TargetCurrency
+	if !prices.RateTime.IsZero() {
+		pbPrices.RateTime = prices.RateTime.Format("2006-01-02 15:04:05")
+	}
+
 	for _, price := range prices.Results {
-		var pbPrice ms.ESStockPrice
-		pbPrice.SID = price.SPID
-		pbPrice.IsAuthorizeddealer = price.IsAuthorizeddealer
-		pbPrice.DistributorID = int32(price.DistributorID)
-		pbPrice.DistributorType = int32(price.DistributorType)
-
-		// 分销商扩展字段，这里可以拿到自定义的分销商扩展字段
-		distributorExt := utility.SplitStrToMap(price.DistributorExt)
-		if len(distributorExt[0]) > 0 {
-			pbPrice.Distributor = distributorExt[0][0]
+		pbPrice := MapperToESStockPrice(price)
+		if converted, ok := prices.Converted[price.SPID]; ok {
+			pbPrice.ConvertedPrice1 = converted.Price1
+			pbPrice.ConvertedPrice2 = converted.Price2
+			pbPrice.ConvertedPrice3 = converted.Price3
+			pbPrice.ConvertedPrice4 = converted.Price4
+			pbPrice.ConvertedPrice5 = converted.Price5
 		}
+		pbPrices.Data = append(pbPrices.Data, pbPrice)
+	}
 
-		// 产品扩展字段，这里可以拿到自定义的产品扩展字段
-		priceExt := utility.SplitStrToMap(price.PriceExt)
-		if len(priceExt[0]) > 0 {
-			if len(priceExt[0]) > 1 {
-				pbPrice.ProductName = priceExt[0][1]
-			}
-		}
+	return &pbPrices, nil
+}
 
-		// 库存
-		if price.StockNum > 0 {
-			pbPrice.StockNum = int32(price.StockNum)
-		}
+// MapperToESStockPrice 将 model.StockPrice 转换成 ms.ESStockPrice
+func MapperToESStockPrice(price model.StockPrice) *ms.ESStockPrice {
+	var pbPrice ms.ESStockPrice
+	pbPrice.SID = price.SPID
+	pbPrice.IsAuthorizeddealer = price.IsAuthorizeddealer
+	pbPrice.DistributorID = int32(price.DistributorID)
+	pbPrice.DistributorType = int32(price.DistributorType)
 
-		// 币种
-		if len(price.Currency) > 0 {
-			pbPrice.Currency = price.Currency
+	// 分销商扩展字段，这里可以拿到自定义的分销商扩展字段
+	distributorExt := utility.SplitStrToMap(price.DistributorExt)
+	if len(distributorExt[0]) > 0 {
+		pbPrice.Distributor = distributorExt[0][0]
+	}
+
+	// 产品扩展字段，这里可以拿到自定义的产品扩展字段
+	priceExt := utility.SplitStrToMap(price.PriceExt)
+	if len(priceExt[0]) > 0 {
+		if len(priceExt[0]) > 1 {
+			pbPrice.ProductName = priceExt[0][1]
 		}
+	}
 
-		if len(price.StepPrice1) > 0 {
-			price1, err := strconv.ParseFloat(price.StepPrice1, 32)
-			if err == nil {
-				pbPrice.Price1 = float32(price1)
-			}
+	// 库存
+	if price.StockNum > 0 {
+		pbPrice.StockNum = int32(price.StockNum)
+	}
+
+	// 币种
+	if len(price.Currency) > 0 {
+		pbPrice.Currency = price.Currency
+	}
+
+	if len(price.StepPrice1) > 0 {
+		price1, err := strconv.ParseFloat(price.StepPrice1, 32)
+		if err == nil {
+			pbPrice.Price1 = float32(price1)
 		}
-		if len(price.StepPrice2) > 0 {
-			price2, err := strconv.ParseFloat(price.StepPrice2, 32)
-			if err == nil {
-				pbPrice.Price2 = float32(price2)
-			}
+	}
+	if len(price.StepPrice2) > 0 {
+		price2, err := strconv.ParseFloat(price.StepPrice2, 32)
+		if err == nil {
+			pbPrice.Price2 = float32(price2)
 		}
-		if len(price.StepPrice3) > 0 {
-			price3, err := strconv.ParseFloat(price.StepPrice3, 32)
-			if err == nil {
-				pbPrice.Price3 = float32(price3)
-			}
+	}
+	if len(price.StepPrice3) > 0 {
+		price3, err := strconv.ParseFloat(price.StepPrice3, 32)
+		if err == nil {
+			pbPrice.Price3 = float32(price3)
 		}
-		if len(price.StepPrice4) > 0 {
-			price4, err := strconv.ParseFloat(price.StepPrice4, 32)
-			if err == nil {
-				pbPrice.Price4 = float32(price4)
-			}
+	}
+	if len(price.StepPrice4) > 0 {
+		price4, err := strconv.ParseFloat(price.StepPrice4, 32)
+		if err == nil {
+			pbPrice.Price4 = float32(price4)
 		}
-		if len(price.StepPrice5) > 0 {
-			price1w, err := strconv.ParseFloat(price.StepPrice5, 32)
-			if err == nil {
-				pbPrice.Price5 = float32(price1w)
-			}
+	}
+	if len(price.StepPrice5) > 0 {
+		price1w, err := strconv.ParseFloat(price.StepPrice5, 32)
+		if err == nil {
+			pbPrice.Price5 = float32(price1w)
 		}
+	}
+
+	pbPrice.UpdatedUtc = price.UpdateTime.Format("2006-01-02 15:04:05")
+
+	pbPrice.MOQ = int32(price.MOQ)
+	pbPrice.Multiples = int32(price.Multiples)
+	for _, priceBreak := range price.PriceBreaks {
+		pbPrice.PriceBreaks = append(pbPrice.PriceBreaks, &ms.PriceBreak{
+			Qty:   int32(priceBreak.Qty),
+			Price: priceBreak.Price,
+		})
+	}
 
-		pbPrice.UpdatedUtc = price.UpdateTime.Format("2006-01-02 15:04:05")
+	return &pbPrice
+}
+
+// MapperToSearchPricesByProductsResult 将 models.CollapseSearchPriceResult 转换成 ms.SearchPricesByProductsResult
+func MapperToSearchPricesByProductsResult(prices *models.CollapseSearchPriceResult) (*ms.SearchPricesByProductsResult, error) {
+	var pbPrices ms.SearchPricesByProductsResult
+	pbPrices.Total = prices.Total
+	pbPrices.TopSize = prices.Size
 
-		pbPrices.Data = append(pbPrices.Data, &pbPrice)
+	for _, collapse := range prices.Results {
+		pbProductPrices := ms.ProductPrices{
+			PID:   collapse.PID,
+			Total: collapse.Total,
+		}
+		for _, price := range collapse.Results {
+			pbProductPrices.Data = append(pbProductPrices.Data, MapperToESStockPrice(price))
+		}
+		pbPrices.Data = append(pbPrices.Data, &pbProductPrices)
 	}
 
 	return &pbPrices, nil
 }
+
+// MapperToExportPriceParam 将 ms.ExportPricesParam 转换成 model.ExportPriceParam
+func MapperToExportPriceParam(req *ms.ExportPricesParam) model.ExportPriceParam {
+	return model.ExportPriceParam{
+		BrandID:       req.BrandID,
+		DistributorID: req.DistributorID,
+		ChunkSize:     req.ChunkSize,
+		ResumeToken:   req.ResumeToken,
+	}
+}
+
+// MapperToExportPricesChunk 将 models.ExportPriceChunk 转换成 ms.ExportPricesChunk
+func MapperToExportPricesChunk(chunk *models.ExportPriceChunk) *ms.ExportPricesChunk {
+	var pbChunk ms.ExportPricesChunk
+	pbChunk.Total = chunk.Total
+	pbChunk.ResumeToken = chunk.ResumeToken
+
+	for _, price := range chunk.Results {
+		pbChunk.Data = append(pbChunk.Data, MapperToESStockPrice(price))
+	}
+
+	return &pbChunk
+}
+
+// MapperToQuoteParam 将 ms.QuoteParam 转换成 model.QuoteParam
+func MapperToQuoteParam(req *ms.QuoteParam) model.QuoteParam {
+	return model.QuoteParam{
+		PriceSearchParam: model.PriceSearchParam{
+			PID:             req.PID,
+			InStock:         req.InStock,
+			AuthorizedOnly:  req.AuthorizedOnly,
+			DistributorType: req.DistributorType,
+			DistributorIDs:  req.DistributorIDs,
+			Currencies:      req.Currencies,
+			TargetCurrency:  req.TargetCurrency,
+		},
+		Quantity: req.Quantity,
+	}
+}
+
+// MapperToQuotePricesResult 将 models.QuotePriceResult 转换成 ms.QuotePricesResult
+func MapperToQuotePricesResult(quotes *models.QuotePriceResult) *ms.QuotePricesResult {
+	var pbQuotes ms.QuotePricesResult
+	pbQuotes.PID = quotes.PID
+	pbQuotes.Quantity = quotes.Quantity
+	pbQuotes.TargetCurrency = quotes.TargetCurrency
+	if !quotes.RateTime.IsZero() {
+		pbQuotes.RateTime = quotes.RateTime.Format("2006-01-02 15:04:05")
+	}
+
+	for _, quote := range quotes.Results {
+		pbQuotes.Data = append(pbQuotes.Data, mapperToPriceQuote(quote))
+	}
+
+	return &pbQuotes
+}
+
+// mapperToPriceQuote 将 models.PriceQuote 转换成 ms.PriceQuote
+func mapperToPriceQuote(quote models.PriceQuote) *ms.PriceQuote {
+	return &ms.PriceQuote{
+		Price:                  MapperToESStockPrice(quote.Price),
+		OrderQty:               int32(quote.OrderQty),
+		BreakQty:               int32(quote.BreakQty),
+		UnitPrice:              quote.UnitPrice,
+		ExtendedPrice:          quote.ExtendedPrice,
+		ConvertedUnitPrice:     quote.ConvertedUnitPrice,
+		ConvertedExtendedPrice: quote.ConvertedExtendedPrice,
+		StockEnough:            quote.StockEnough,
+	}
+}
@@ -0,0 +1,191 @@
+package dto
+
+import (
+	"easyms-es/model"
+	ms "easyms-es/protos/messages"
+	"easyms-es/service/models"
+	"easyms-es/utility"
+)
+
+// MapperToProductSearchParam 将 ms.ProductSearchParam 转换成 model.ProductSearchParam
+func MapperToProductSearchParam(req *ms.ProductSearchParam) model.ProductSearchParam {
+	param := model.ProductSearchParam{
+		KeyWord:        req.KeyWord,
+		ProductName:    req.ProductName,
+		Brand:          req.Brand,
+		Category:       req.Category,
+		PassiveParam:   req.PassiveParam,
+		ParentIDs:      req.ParentIDs,
+		CategoryIDs:    req.CategoryIDs,
+		BrandIDs:       req.BrandIDs,
+		DistributorIDs: req.DistributorIDs,
+		IsHighlight:    req.IsHighlight,
+		Size:           req.Size,
+		From:           req.From,
+	}
+	for _, attribute := range req.Attributes {
+		param.Attributes = append(param.Attributes, model.Attribute{
+			AttributeName:   attribute.AttributeName,
+			AttributeValues: attribute.AttributeValues,
+		})
+	}
+	return param
+}
+
+// MapperToCustomSearchProductParam 将 ms.CustomSearchProductParam 转换成 model.CustomSearchProductParam
+func MapperToCustomSearchProductParam(req *ms.CustomSearchProductParam) model.CustomSearchProductParam {
+	param := model.CustomSearchProductParam{
+		KeyWords:       make(map[string][]string),
+		Filters:        req.Filters,
+		TermsFilters:   req.TermsFilters,
+		AttributeNames: req.AttributeNames,
+		Sources:        req.Sources,
+		Sorts:          req.Sorts,
+		IsHighlight:    req.IsHighlight,
+		Size:           req.Size,
+		From:           req.From,
+	}
+	for field, keyWords := range req.KeyWords {
+		if keyWords != nil {
+			param.KeyWords[field] = keyWords.Values
+		}
+	}
+	if req.Range != nil {
+		param.Range = model.CustomRange{
+			Field: req.Range.Field,
+			Gte:   req.Range.Gte,
+			Lte:   req.Range.Lte,
+		}
+	}
+	for _, boot := range req.Boots {
+		param.Boots = append(param.Boots, int(boot))
+	}
+	return param
+}
+
+// MapperToSearchProductsResult 将 models.SearchProductResult 转换成 ms.SearchProductsResult
+func MapperToSearchProductsResult(products *models.SearchProductResult) (*ms.SearchProductsResult, error) {
+	var pbProducts ms.SearchProductsResult
+	pbProducts.Total = products.Total
+	pbProducts.Above = products.Above
+	pbProducts.From = products.From
+	pbProducts.Size = products.Size
+	pbProducts.IsReSearch = products.IsReSearch
+	pbProducts.SimilarProductNames = products.SimilarProductNames
+
+	for _, product := range products.Results {
+		pbProduct := MapperToESProduct(product)
+		pbProduct.HighlightProductName = products.Highlights[product.PID]
+		pbProducts.Data = append(pbProducts.Data, pbProduct)
+	}
+
+	return &pbProducts, nil
+}
+
+// MapperToESProduct 将 model.Product 转换成 ms.ESProduct, 扩展字段按约定的拼接格式拆分
+func MapperToESProduct(product model.Product) *ms.ESProduct {
+	var pbProduct ms.ESProduct
+	pbProduct.PID = int32(product.PID)
+	pbProduct.BrandID = int32(product.BrandID)
+	pbProduct.ParentID = int32(product.ParentID)
+	pbProduct.CategoryID = int32(product.CategoryID)
+	pbProduct.PriceGroup = int32(product.PriceGroup)
+
+	// 产品扩展字段 {ProductName},{Brand},{Description},{Canonical}
+	productExt := utility.SplitStrToMap(product.ProductExt)
+	if len(productExt[0]) > 0 {
+		pbProduct.ProductName = productExt[0][0]
+	}
+	if len(productExt[0]) > 1 {
+		pbProduct.Brand = productExt[0][1]
+	}
+	if len(productExt[0]) > 2 {
+		pbProduct.Description = productExt[0][2]
+	}
+
+	// 品牌扩展字段 {品牌表的品牌名称},{品牌ID对应的标准名},{品牌图标}
+	manufacturer := utility.SplitStrToMap(product.Manufacturer)
+	if len(manufacturer[0]) > 0 && len(manufacturer[0][0]) > 0 {
+		pbProduct.Brand = manufacturer[0][0]
+	}
+
+	// 分类扩展字段 {父级分类},{父级分类SEOName},{父级分类SEOTitle};{分类},{分类SEOName},{分类SEOTitle}
+	category := utility.SplitStrToMap(product.Category)
+	if len(category[0]) > 0 {
+		pbProduct.ParentCategory = category[0][0]
+	}
+	if len(category[1]) > 0 {
+		pbProduct.Category = category[1][0]
+	}
+
+	// 资源扩展字段 {PicUrl1},{PicUrl2};{PdfUrl}
+	resUrls := utility.SplitStrToMap(product.ResUrls)
+	if len(resUrls[0]) > 0 {
+		pbProduct.PicUrl = resUrls[0][0]
+	}
+	if len(resUrls[1]) > 0 {
+		pbProduct.PdfUrl = resUrls[1][0]
+	}
+
+	return &pbProduct
+}
+
+// MapperToProductIndexSearchParam 将 ms.ProductIndexSearchParam 转换成 model.ProductIndexSearchParam
+func MapperToProductIndexSearchParam(req *ms.ProductIndexSearchParam) model.ProductIndexSearchParam {
+	return model.ProductIndexSearchParam{
+		ProductIndexParam: model.ProductIndexParam{
+			ProductNameIndex: req.ProductNameIndex,
+			IsFollow:         req.IsFollow,
+			ParentID:         req.ParentID,
+			CategoryID:       req.CategoryID,
+		},
+		Size:    req.Size,
+		LastPID: req.LastPID,
+	}
+}
+
+// MapperToProductIndexResult 将 models.SearchProductIndexResult 转换成 ms.ProductIndexResult
+func MapperToProductIndexResult(products *models.SearchProductIndexResult) *ms.ProductIndexResult {
+	var pbProducts ms.ProductIndexResult
+	pbProducts.Total = products.Total
+	pbProducts.Size = products.Size
+	pbProducts.LastPID = products.LastPID
+
+	for _, product := range products.Results {
+		pbProducts.Data = append(pbProducts.Data, MapperToESProduct(product))
+	}
+	for _, index := range products.Indexes {
+		pbProducts.Indexes = append(pbProducts.Indexes, &ms.ProductIndexCount{
+			ProductNameIndex: int32(index.ProductNameIndex),
+			Initial:          index.Initial,
+			Count:            int32(index.Count),
+		})
+	}
+
+	return &pbProducts
+}
+
+// MapperToExportProductParam 将 ms.ExportProductsParam 转换成 model.ExportProductParam
+func MapperToExportProductParam(req *ms.ExportProductsParam) model.ExportProductParam {
+	return model.ExportProductParam{
+		BrandID:       req.BrandID,
+		ParentID:      req.ParentID,
+		CategoryID:    req.CategoryID,
+		DistributorID: req.DistributorID,
+		ChunkSize:     req.ChunkSize,
+		ResumeToken:   req.ResumeToken,
+	}
+}
+
+// MapperToExportProductsChunk 将 models.ExportProductChunk 转换成 ms.ExportProductsChunk
+func MapperToExportProductsChunk(chunk *models.ExportProductChunk) *ms.ExportProductsChunk {
+	var pbChunk ms.ExportProductsChunk
+	pbChunk.Total = chunk.Total
+	pbChunk.ResumeToken = chunk.ResumeToken
+
+	for _, product := range chunk.Results {
+		pbChunk.Data = append(pbChunk.Data, MapperToESProduct(product))
+	}
+
+	return &pbChunk
+}
@@ -0,0 +1,139 @@
+package gateway
+
+import (
+	"easyms-es/errno"
+	ms "easyms-es/protos/messages"
+	pb "easyms-es/protos/services"
+	"encoding/csv"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+)
+
+// CSV导出的列, 每个报价一行, 未匹配的行只输出匹配信息
+var bomCsvHeader = []string{
+	"Line", "PartNumber", "Manufacturer", "Quantity", "MatchType", "Confidence",
+	"PID", "ProductName", "Brand", "Distributor", "StockNum", "OrderQty",
+	"Currency", "UnitPrice", "ExtendedPrice", "ConvertedUnitPrice", "ConvertedExtendedPrice", "Error",
+}
+
+// MatchBOMCsvHandler BOM的CSV接口, 请求体为 PartNumber,Manufacturer,Quantity 三列(首行表头可选),
+// 查询参数 TopOffers、TargetCurrency 与 MatchBOM 一致
+func MatchBOMCsvHandler(client pb.BomServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		rows, err := readBomCsv(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		param := &ms.MatchBOMParam{
+			Rows:           rows,
+			TargetCurrency: r.URL.Query().Get("TargetCurrency"),
+		}
+		if topOffers := r.URL.Query().Get("TopOffers"); len(topOffers) > 0 {
+			n, err := strconv.Atoi(topOffers)
+			if err != nil {
+				http.Error(w, "param error: TopOffers is error", http.StatusBadRequest)
+				return
+			}
+			param.TopOffers = int32(n)
+		}
+
+		res, err := client.MatchBOM(r.Context(), param)
+		if err != nil {
+			http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="bom.csv"`)
+		writeBomCsv(w, res)
+	}
+}
+
+// readBomCsv 读取BOM行, 数量列不是数字的首行视为表头
+func readBomCsv(body io.Reader) ([]*ms.BomRow, error) {
+	reader := csv.NewReader(body)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, errno.InvalidParam("csv is error: %v", err)
+	}
+
+	var rows []*ms.BomRow
+	for i, record := range records {
+		if len(record) == 0 || len(strings.TrimSpace(strings.Join(record, ""))) == 0 {
+			continue
+		}
+		row := &ms.BomRow{PartNumber: strings.TrimSpace(record[0])}
+		if len(record) > 1 {
+			row.Manufacturer = strings.TrimSpace(record[1])
+		}
+		if len(record) > 2 && len(strings.TrimSpace(record[2])) > 0 {
+			quantity, err := strconv.Atoi(strings.TrimSpace(record[2]))
+			if err != nil {
+				if i == 0 {
+					continue
+				}
+				return nil, errno.InvalidParam("csv line %d quantity is error: %s", i+1, record[2])
+			}
+			row.Quantity = int32(quantity)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// writeBomCsv 输出BOM匹配结果
+func writeBomCsv(w io.Writer, res *ms.MatchBOMResult) {
+	writer := csv.NewWriter(w)
+	_ = writer.Write(bomCsvHeader)
+
+	for _, line := range res.Lines {
+		row := line.GetRow()
+		base := []string{
+			strconv.Itoa(int(line.Line)),
+			row.GetPartNumber(),
+			row.GetManufacturer(),
+			strconv.Itoa(int(row.GetQuantity())),
+			line.MatchType,
+			strconv.FormatFloat(float64(line.Confidence), 'f', 2, 32),
+		}
+		var product []string
+		if line.Product != nil {
+			product = []string{strconv.Itoa(int(line.Product.PID)), line.Product.ProductName, line.Product.Brand}
+		} else {
+			product = []string{"", "", ""}
+		}
+
+		if len(line.Offers) == 0 {
+			record := append(append(base, product...), "", "", "", "", "", "", "", "", line.Error)
+			_ = writer.Write(record)
+			continue
+		}
+		for _, offer := range line.Offers {
+			price := offer.GetPrice()
+			record := append(append([]string{}, base...), product...)
+			record = append(record,
+				price.GetDistributor(),
+				strconv.Itoa(int(price.GetStockNum())),
+				strconv.Itoa(int(offer.OrderQty)),
+				price.GetCurrency(),
+				offer.UnitPrice,
+				offer.ExtendedPrice,
+				offer.ConvertedUnitPrice,
+				offer.ConvertedExtendedPrice,
+				line.Error,
+			)
+			_ = writer.Write(record)
+		}
+	}
+	writer.Flush()
+}
@@ -0,0 +1,137 @@
+// Package gateway REST网关, 将 grpc-gateway 与 Swagger UI 挂载在API进程内
+package gateway
+
+import (
+	"context"
+	pb "easyms-es/protos/services"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// 进程内连接的缓冲区大小
+const bufSize = 1 << 20
+
+// 转发为 gRPC metadata 的请求头, 与拦截器读取的客户端信息一致
+var forwardHeaders = map[string]bool{
+	"client-id":       true,
+	"client-secret":   true,
+	"user-real-ip":    true,
+	"user-real-agent": true,
+	"authorization":   true,
+	"x-request-id":    true,
+	"traceparent":     true,
+	"tracestate":      true,
+	"baggage":         true,
+}
+
+// headerMatcher 授权及链路追踪相关请求头原样转发, 其他请求头使用默认规则
+func headerMatcher(key string) (string, bool) {
+	if forwardHeaders[strings.ToLower(key)] {
+		return key, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// ServeInProcess 在内存连接上启动gRPC服务并返回客户端连接, 网关请求不经过网络及TLS
+func ServeInProcess(s *grpc.Server) (*grpc.ClientConn, error) {
+	listener := bufconn.Listen(bufSize)
+	go func() {
+		if err := s.Serve(listener); err != nil {
+			log.Printf("failed to serve in-process grpc: %v", err)
+		}
+	}()
+
+	return grpc.NewClient("passthrough:///inprocess",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+}
+
+// outgoingHeaderMatcher 限流的 retry-after 及请求ID转为标准响应头, 其他使用默认规则
+func outgoingHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case "retry-after":
+		return "Retry-After", true
+	case "x-request-id":
+		return "X-Request-Id", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// errorHandler 错误响应使用默认规则(HTTP状态码按gRPC状态码映射, 响应体包含 details),
+// 带 RetryInfo 且服务端未返回 retry-after 时补充 Retry-After 响应头
+func errorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	md, _ := runtime.ServerMetadataFromContext(ctx)
+	if len(md.HeaderMD.Get("retry-after")) == 0 {
+		for _, detail := range status.Convert(err).Details() {
+			if info, ok := detail.(*errdetails.RetryInfo); ok {
+				seconds := int64(math.Ceil(info.GetRetryDelay().AsDuration().Seconds()))
+				w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+			}
+		}
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+// NewServeMux 创建网关mux, 转发授权请求头
+func NewServeMux() *runtime.ServeMux {
+	return runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithErrorHandler(errorHandler),
+	)
+}
+
+// Register 在mux上注册全部服务的REST接口及BOM的CSV接口
+func Register(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	if err := pb.RegisterProductsSearchServiceHandler(ctx, mux, conn); err != nil {
+		return err
+	}
+	if err := pb.RegisterPriceSearchServiceHandler(ctx, mux, conn); err != nil {
+		return err
+	}
+	if err := pb.RegisterBomServiceHandler(ctx, mux, conn); err != nil {
+		return err
+	}
+	if err := pb.RegisterAdminServiceHandler(ctx, mux, conn); err != nil {
+		return err
+	}
+	return mux.HandlePath("POST", "/v1/MatchBOM/csv", MatchBOMCsvHandler(pb.NewBomServiceClient(conn)))
+}
+
+// NewHandler 创建网关的HTTP处理器, /v1 为REST接口, /swagger 为 Swagger UI
+func NewHandler(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+	mux := NewServeMux()
+	if err := Register(ctx, mux, conn); err != nil {
+		return nil, err
+	}
+
+	g := gin.New()
+	g.Use(gin.Recovery())
+
+	g.GET("/swagger-doc/swagger.json", func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json; charset=utf-8", pb.SwaggerJSON)
+	})
+	url := ginSwagger.URL("/swagger-doc/swagger.json")
+	g.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, url))
+
+	g.Any("/v1/*any", gin.WrapH(mux))
+
+	return g, nil
+}
@@ -78,6 +78,13 @@ func (c *Conn) RemoteAddr() net.Addr {
 	return c.conn.RemoteAddr()
 }
 
+// ConnectionState 获取TLS连接状态
+// 返回:
+//   tls.ConnectionState - TLS握手结果, 包含对端证书
+func (c *Conn) ConnectionState() tls.ConnectionState {
+	return c.conn.ConnectionState().TLS
+}
+
 // SetDeadline 设置连接截止时间
 // 参数:
 //   t - 截止时间
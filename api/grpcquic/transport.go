@@ -10,8 +10,9 @@ import (
 )
 
 // Info 传输信息封装
-// 包含底层QUIC连接
+// 包含底层QUIC连接, QUIC 自带TLS, 安全级别为 PrivacyAndIntegrity
 type Info struct {
+	credentials.CommonAuthInfo
 	conn *Conn
 }
 
@@ -24,7 +25,10 @@ type Info struct {
 //
 //	*Info - 传输信息对象
 func NewInfo(c *Conn) *Info {
-	return &Info{c}
+	return &Info{
+		CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		conn:           c,
+	}
 }
 
 // AuthType 获取认证类型
@@ -43,6 +47,14 @@ func (i *Info) Conn() net.Conn {
 	return i.conn
 }
 
+// TLSState 获取QUIC连接的TLS状态
+// 返回:
+//
+//	tls.ConnectionState - 包含对端证书及校验链
+func (i *Info) TLSState() tls.ConnectionState {
+	return i.conn.ConnectionState()
+}
+
 // Credentials 传输凭证封装
 // 实现gRPC TransportCredentials接口
 type Credentials struct {
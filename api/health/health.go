@@ -0,0 +1,181 @@
+// Package health 健康检查, 后台定时检查依赖并更新 grpc.health.v1 的服务状态, 同时提供HTTP探针
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Server gRPC健康检查服务, TCP、QUIC及网关的进程内服务共用
+var Server = grpchealth.NewServer()
+
+// Check 依赖检查项
+type Check struct {
+	Name     string
+	Critical bool     // 关键依赖不可用时相关服务为 NOT_SERVING
+	Services []string // 依赖此项的gRPC服务, 为空表示全部服务
+	Probe    func(ctx context.Context) error
+}
+
+// CheckResult 依赖检查结果
+type CheckResult struct {
+	Name      string    `json:"name"`
+	Critical  bool      `json:"critical"`
+	Healthy   bool      `json:"healthy"`
+	Error     string    `json:"error,omitempty"`
+	Latency   int64     `json:"latency"` // 毫秒
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+var (
+	checks   []Check
+	services []string
+	results  []CheckResult
+	ready    bool
+	stopping bool
+	mu       sync.RWMutex
+)
+
+// Register 注册依赖检查项, 需在 Start 之前调用
+func Register(check Check) {
+	checks = append(checks, check)
+}
+
+// Start 立即执行一次检查, 之后按 interval 定时检查, serviceNames 为需要维护状态的gRPC服务, 返回停止函数
+func Start(interval time.Duration, timeout time.Duration, serviceNames []string) func() {
+	services = serviceNames
+	run(timeout)
+
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				run(timeout)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		ticker.Stop()
+		close(done)
+	}
+}
+
+// run 并发执行全部检查并更新服务状态
+func run(timeout time.Duration) {
+	current := make([]CheckResult, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := check.Probe(ctx)
+			current[i] = CheckResult{
+				Name:      check.Name,
+				Critical:  check.Critical,
+				Healthy:   err == nil,
+				Latency:   time.Since(start).Milliseconds(),
+				CheckedAt: start,
+			}
+			if err != nil {
+				current[i].Error = err.Error()
+			}
+		}(i, check)
+	}
+	wg.Wait()
+
+	overall := true
+	for _, service := range services {
+		serving := true
+		for i, check := range checks {
+			if check.Critical && !current[i].Healthy && affects(check, service) {
+				serving = false
+			}
+		}
+		Server.SetServingStatus(service, servingStatus(serving))
+	}
+	for i, check := range checks {
+		if check.Critical && !current[i].Healthy {
+			overall = false
+		}
+	}
+	Server.SetServingStatus("", servingStatus(overall))
+
+	mu.Lock()
+	results = current
+	ready = overall
+	mu.Unlock()
+}
+
+// affects 检查项是否影响指定服务
+func affects(check Check, service string) bool {
+	if len(check.Services) == 0 {
+		return true
+	}
+	for _, s := range check.Services {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
+
+func servingStatus(serving bool) healthpb.HealthCheckResponse_ServingStatus {
+	if serving {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
+
+// Ready 是否可以接收请求及最近一次的检查结果, 停机过程中始终为未就绪
+func Ready() (bool, []CheckResult) {
+	mu.RLock()
+	defer mu.RUnlock()
+	return ready && !stopping, results
+}
+
+// Shutdown 停机时将全部服务置为 NOT_SERVING, 之后的检查结果不再更新状态
+func Shutdown() {
+	mu.Lock()
+	stopping = true
+	mu.Unlock()
+	Server.Shutdown()
+}
+
+// Healthz 存活探针, 进程可以处理HTTP请求即返回200
+func Healthz(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok"))
+}
+
+// Readyz 就绪探针, 关键依赖不可用时返回503, 响应体为各依赖的检查结果
+func Readyz(w http.ResponseWriter, _ *http.Request) {
+	isReady, checkResults := Ready()
+
+	statusCode := http.StatusOK
+	if !isReady {
+		statusCode = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"ready":  isReady,
+		"checks": checkResults,
+	})
+}
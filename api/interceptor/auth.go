@@ -0,0 +1,63 @@
+package interceptor
+
+import (
+	"context"
+	"easyms-es/api/auth"
+	"easyms-es/api/logger"
+	"easyms-es/errno"
+	"easyms-es/metrics"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// AuthUnaryInterceptor 按监听器的认证方式校验调用方, 通过后将身份写入上下文, 失败时记录访问日志及指标
+func AuthUnaryInterceptor(mode auth.Mode) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		// 健康检查不校验客户端
+		if logger.IsHealthMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		startTime := time.Now()
+		identity, err := authenticate(ctx, info.FullMethod, mode)
+		if err != nil {
+			logger.LogAccess(ctx, info.FullMethod, req, startTime, err)
+			err = errno.HandleError(err)
+			metrics.ObserveGrpc(info.FullMethod, err, startTime)
+			return nil, err
+		}
+		return handler(auth.WithIdentity(ctx, identity), req)
+	}
+}
+
+// AuthStreamInterceptor 流式请求的认证, 与一元拦截器相同
+func AuthStreamInterceptor(mode auth.Mode) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if logger.IsHealthMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		startTime := time.Now()
+		identity, err := authenticate(ss.Context(), info.FullMethod, mode)
+		if err != nil {
+			logger.LogAccess(ss.Context(), info.FullMethod, nil, startTime, err)
+			err = errno.HandleError(err)
+			metrics.ObserveGrpc(info.FullMethod, err, startTime)
+			return err
+		}
+		return handler(srv, wrapStream(ss, auth.WithIdentity(ss.Context(), identity)))
+	}
+}
+
+// authenticate 读取metadata中的凭证及连接上的客户端证书进行认证
+func authenticate(ctx context.Context, fullMethod string, mode auth.Mode) (*auth.Identity, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, errno.Unauthenticated("missing metadata")
+	}
+	p, _ := peer.FromContext(ctx)
+	return auth.Authenticate(md, auth.PeerCertificate(p), fullMethod, mode)
+}
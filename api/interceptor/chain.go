@@ -0,0 +1,62 @@
+// Package interceptor gRPC服务端拦截器链, TCP、QUIC 及网关的进程内服务使用相同的顺序
+package interceptor
+
+import (
+	"context"
+	"easyms-es/api/auth"
+	"easyms-es/api/logger"
+	"easyms-es/api/ratelimit"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryChain 一元拦截器链, 依次为 链路追踪、异常恢复、请求ID、认证、限流、访问日志、指标、参数校验
+func UnaryChain(mode auth.Mode) []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{
+		TracingUnaryInterceptor(),
+		RecoveryUnaryInterceptor(),
+		RequestIDUnaryInterceptor(),
+		AuthUnaryInterceptor(mode),
+		ratelimit.UnaryInterceptor(),
+		logger.GrpcLoggerUnaryInterceptor(),
+		MetricsUnaryInterceptor(),
+		ValidateUnaryInterceptor(),
+	}
+}
+
+// StreamChain 流拦截器链, 顺序与一元拦截器链一致
+func StreamChain(mode auth.Mode) []grpc.StreamServerInterceptor {
+	return []grpc.StreamServerInterceptor{
+		TracingStreamInterceptor(),
+		RecoveryStreamInterceptor(),
+		RequestIDStreamInterceptor(),
+		AuthStreamInterceptor(mode),
+		ratelimit.StreamInterceptor(),
+		logger.GrpcLoggerStreamInterceptor(),
+		MetricsStreamInterceptor(),
+		ValidateStreamInterceptor(),
+	}
+}
+
+// ServerOptions 按监听器的认证方式生成拦截器链的服务选项
+func ServerOptions(mode auth.Mode) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryChain(mode)...),
+		grpc.ChainStreamInterceptor(StreamChain(mode)...),
+	}
+}
+
+// wrappedStream 替换流的上下文, 使后续拦截器及服务实现能读取请求ID、调用方身份等信息
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *wrappedStream) Context() context.Context {
+	return s.ctx
+}
+
+// wrapStream 使用新的上下文包装流
+func wrapStream(ss grpc.ServerStream, ctx context.Context) grpc.ServerStream {
+	return &wrappedStream{ServerStream: ss, ctx: ctx}
+}
@@ -0,0 +1,30 @@
+package interceptor
+
+import (
+	"context"
+	"easyms-es/errno"
+	"easyms-es/metrics"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// MetricsUnaryInterceptor 记录请求数及耗时, 状态码按 errno.HandleError 转换, 认证、限流拒绝及panic由对应拦截器记录
+func MetricsUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		startTime := time.Now()
+		resp, err := handler(ctx, req)
+		metrics.ObserveGrpc(info.FullMethod, errno.HandleError(err), startTime)
+		return resp, err
+	}
+}
+
+// MetricsStreamInterceptor 流式请求的请求数及耗时, 耗时为整个流的持续时间
+func MetricsStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		startTime := time.Now()
+		err := handler(srv, ss)
+		metrics.ObserveGrpc(info.FullMethod, errno.HandleError(err), startTime)
+		return err
+	}
+}
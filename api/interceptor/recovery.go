@@ -0,0 +1,51 @@
+package interceptor
+
+import (
+	"context"
+	"easyms-es/api/logger"
+	"easyms-es/errno"
+	"easyms-es/metrics"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// RecoveryUnaryInterceptor 捕获服务实现中的panic, 返回 Internal 错误并记录访问日志, 避免进程退出
+func RecoveryUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		startTime := time.Now()
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(ctx, info.FullMethod, req, startTime, r)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// RecoveryStreamInterceptor 捕获流式服务实现中的panic
+func RecoveryStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		startTime := time.Now()
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(ss.Context(), info.FullMethod, nil, startTime, r)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+// recovered 记录panic堆栈, panic 跳过了内层的访问日志及指标拦截器, 在此补记
+func recovered(ctx context.Context, fullMethod string, req interface{}, startTime time.Time, r interface{}) error {
+	log.Printf("panic in %s: %v\n%s", fullMethod, r, debug.Stack())
+	err := errno.Internal(fmt.Errorf("panic: %v", r))
+	if !logger.IsHealthMethod(fullMethod) {
+		logger.LogAccess(ctx, fullMethod, req, startTime, err)
+	}
+	metrics.ObserveGrpc(fullMethod, err, startTime)
+	return err
+}
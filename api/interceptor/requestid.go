@@ -0,0 +1,47 @@
+package interceptor
+
+import (
+	"context"
+	"easyms-es/api/logger"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// 客户端传入的请求ID最大长度, 超出时重新生成
+const maxRequestIDLength = 128
+
+// RequestIDUnaryInterceptor 读取或生成请求ID, 写入上下文及响应头
+func RequestIDUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, requestID := withRequestID(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(logger.RequestIDKey, requestID))
+		return handler(ctx, req)
+	}
+}
+
+// RequestIDStreamInterceptor 流式请求的请求ID
+func RequestIDStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, requestID := withRequestID(ss.Context())
+		_ = ss.SetHeader(metadata.Pairs(logger.RequestIDKey, requestID))
+		return handler(srv, wrapStream(ss, ctx))
+	}
+}
+
+// withRequestID 优先使用客户端传入的 x-request-id, 便于跨服务追踪, 同时记录到当前span
+func withRequestID(ctx context.Context) (context.Context, string) {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(logger.RequestIDKey); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		requestID = logger.NewRequestID()
+	}
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("request.id", requestID))
+	return logger.WithRequestID(ctx, requestID), requestID
+}
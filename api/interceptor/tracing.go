@@ -0,0 +1,67 @@
+package interceptor
+
+import (
+	"context"
+	"easyms-es/tracing"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TracingUnaryInterceptor 为每次调用创建服务端span, 父span为 metadata 中传入的 trace context
+func TracingUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := startSpan(ctx, info.FullMethod)
+		resp, err := handler(ctx, req)
+		endSpan(span, err)
+		return resp, err
+	}
+}
+
+// TracingStreamInterceptor 流式调用的span, 持续时间为整个流
+func TracingStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := startSpan(ss.Context(), info.FullMethod)
+		err := handler(srv, wrapStream(ss, ctx))
+		endSpan(span, err)
+		return err
+	}
+}
+
+// startSpan 按 /package.Service/Method 创建span
+func startSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	return otel.Tracer("easyms-es/api").Start(tracing.Extract(ctx), strings.TrimPrefix(fullMethod, "/"),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(semconv.RPCSystemGRPC, semconv.RPCService(service), semconv.RPCMethod(method)),
+	)
+}
+
+// endSpan 记录gRPC状态码, 服务端错误(Internal、Unavailable等)标记为失败
+func endSpan(span trace.Span, err error) {
+	code := status.Code(err)
+	span.SetAttributes(attribute.Int64(string(semconv.RPCGRPCStatusCodeKey), int64(code)))
+	if err != nil {
+		span.RecordError(err)
+		if isServerError(code) {
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+	}
+	span.End()
+}
+
+// isServerError 是否为服务端错误, 参数错误、认证失败、限流等客户端错误不标记span失败
+func isServerError(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal, codes.Unavailable, codes.DataLoss:
+		return true
+	}
+	return false
+}
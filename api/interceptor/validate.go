@@ -0,0 +1,43 @@
+package interceptor
+
+import (
+	"context"
+	"easyms-es/api/validate"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// ValidateUnaryInterceptor 按登记的规则校验请求参数, 位于拦截器链末尾, 拒绝的请求仍记录访问日志及指标
+func ValidateUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if m, ok := req.(proto.Message); ok {
+			if err := validate.Message(m); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ValidateStreamInterceptor 流式请求的参数校验, 在服务实现读取请求时执行
+func ValidateStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss})
+	}
+}
+
+// validatingStream 接收消息后校验
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		return validate.Message(msg)
+	}
+	return nil
+}
@@ -1,10 +1,14 @@
 package logger
 
 import (
+	"context"
 	"database/sql"
+	"easyms-es/metrics"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -16,6 +20,8 @@ var (
 	db         *sql.DB
 	logChannel = make(chan LogEntry, 100) // 异步日志通道
 	wg         = &sync.WaitGroup{}       // 协程等待组
+	closed     bool                      // 日志通道已关闭, 之后的日志直接丢弃
+	closeLock  sync.RWMutex              // 保护 closed 及通道关闭, 避免停机时向已关闭的通道发送
 )
 
 // LogEntry 日志实体结构
@@ -23,6 +29,7 @@ var (
 type LogEntry struct {
 	Service    string  // 服务名称
 	Method     string  // 方法名称
+	RequestID  string  // 请求ID
 	ClientID   string  // 客户端ID
 	ClientIP   string  // 客户端IP
 	UserIP     string  // 用户IP
@@ -52,6 +59,8 @@ func InitLogger(connString string) error {
 		return err
 	}
 
+	metrics.RegisterLoggerQueue(func() int { return len(logChannel) })
+
 	// Start log processor
 	wg.Add(1)
 	go logProcessor()
@@ -81,11 +90,11 @@ func logProcessor() {
 
 	for {
 		select {
-		case logEntry := <-logChannel:
-			if logEntry.StatusCode > 0 {
-				log.Println(logEntry.Method)
-				log.Println(logEntry.Params)
-				log.Println(logEntry.Error)
+		case logEntry, ok := <-logChannel:
+			// 通道关闭后写入剩余日志并退出
+			if !ok {
+				insertDbLogs(logs)
+				return
 			}
 			logs = append(logs, logEntry)
 			if len(logs) >= 10 {
@@ -102,6 +111,7 @@ func logProcessor() {
 }
 
 // insertDbLogs 批量插入数据库日志
+// 客户端ID、用户IP、用户代理等来自请求metadata, 均按参数传入, 不拼接到SQL中
 // 参数:
 //   logs - 日志条目数组
 func insertDbLogs(logs []LogEntry) {
@@ -109,34 +119,67 @@ func insertDbLogs(logs []LogEntry) {
 		return
 	}
 
-	query := "INSERT INTO easyESTraceLogs (Service, Method, ClientID, ClientIP, UserIP, UserAgent, StatusCode, Latency, Timestamp) VALUES "
-	values := ""
+	const columns = 11
+	var query strings.Builder
+	query.WriteString("INSERT INTO easyESTraceLogs (Service, Method, RequestID, ClientID, ClientIP, UserIP, UserAgent, StatusCode, Latency, Timestamp, Error) VALUES ")
+	args := make([]any, 0, len(logs)*columns)
 
 	// 插入数据库
 	for i, logInfo := range logs {
 		if i > 0 {
-			values += ","
+			query.WriteString(",")
+		}
+		query.WriteString("(")
+		for j := 1; j <= columns; j++ {
+			if j > 1 {
+				query.WriteString(", ")
+			}
+			fmt.Fprintf(&query, "@p%d", i*columns+j)
 		}
-		values += fmt.Sprintf("('%s', '%s', '%s', '%s', '%s', '%s', %d, %d, '%s')",
-			logInfo.Service, logInfo.Method, logInfo.ClientID, logInfo.ClientIP, logInfo.UserIP, logInfo.UserAgent, logInfo.StatusCode, logInfo.Latency, logInfo.Timestamp.Format("2006-01-02 15:04:05"))
+		query.WriteString(")")
+		args = append(args, logInfo.Service, logInfo.Method, logInfo.RequestID, logInfo.ClientID, logInfo.ClientIP, logInfo.UserIP, logInfo.UserAgent,
+			logInfo.StatusCode, logInfo.Latency, logInfo.Timestamp, logInfo.Error)
 	}
 
-	_, err := db.Exec(query + values)
+	_, err := db.Exec(query.String(), args...)
 	if err != nil {
 		log.Println(err.Error())
 	}
 }
 
-// LogAsync 异步记录日志
+// LogAsync 异步记录日志, 队列已满时丢弃, 避免日志库变慢时阻塞请求
 // 参数:
 //   log - 日志条目
 func LogAsync(log LogEntry) {
-	logChannel <- log
+	closeLock.RLock()
+	defer closeLock.RUnlock()
+	if closed {
+		metrics.LoggerDropped.Inc()
+		return
+	}
+	select {
+	case logChannel <- log:
+	default:
+		metrics.LoggerDropped.Inc()
+	}
 }
 
 // CloseLogging 关闭日志系统
-// 等待所有日志处理完成
+// 等待所有日志处理完成, 关闭后仍在执行的请求调用 LogAsync 时丢弃日志
 func CloseLogging() {
-	close(logChannel) // 关闭通道以停止 goroutine
-	wg.Wait()         // 等待日志处理完成
+	closeLock.Lock()
+	if !closed {
+		closed = true
+		close(logChannel) // 关闭通道以停止 goroutine
+	}
+	closeLock.Unlock()
+	wg.Wait() // 等待日志处理完成
+}
+
+// Ping 检查日志数据库连接, 供健康检查使用
+func Ping(ctx context.Context) error {
+	if db == nil {
+		return errors.New("log database is not initialized")
+	}
+	return db.PingContext(ctx)
 }
@@ -1,8 +1,8 @@
 package logger
 
 import (
-	"easyms-es/api/errno"
-	"errors"
+	"easyms-es/api/auth"
+	"easyms-es/errno"
 	"net"
 	"strings"
 	"time"
@@ -17,24 +17,6 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-var (
-	validClientID     = "easy"
-	validClientSecret = "ebsaekcHSan38yNVEKMJd6LfoMyv2KWG"
-)
-
-// 验证客户端授权码
-// 参数:
-//
-//	clientID - 客户端ID
-//	clientSecret - 客户端密钥
-//
-// 返回:
-//
-//	bool - 验证结果
-func validateClient(clientID, clientSecret string) bool {
-	return clientID == validClientID && clientSecret == validClientSecret
-}
-
 // gin 日志中间价件, 添加了客户端校验, 代码已迁移
 //func GinLoggerMiddleware() gin.HandlerFunc {
 //	return func(c *gin.Context) {
@@ -82,8 +64,8 @@ func validateClient(clientID, clientSecret string) bool {
 //	}
 //}
 
-// GrpcLoggerUnaryInterceptor gRPC日志拦截器
-// 实现客户端验证和日志记录功能
+// GrpcLoggerUnaryInterceptor gRPC访问日志拦截器
+// 位于认证及限流之后, 记录请求结果, 认证及限流拒绝的请求由对应拦截器调用 LogAccess 记录
 // 返回:
 //
 //	grpc.UnaryServerInterceptor - 一元拦截器
@@ -94,75 +76,125 @@ func GrpcLoggerUnaryInterceptor() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		var (
-			resp interface{}
-			err  error
-		)
-
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			err = errors.New("missing metadata")
+		// 健康检查不记录日志
+		if IsHealthMethod(info.FullMethod) {
+			return handler(ctx, req)
 		}
 
-		clientID := getMetadataValue(md, "Client-ID")
-		clientSecret := getMetadataValue(md, "Client-Secret")
+		startTime := time.Now()
+		resp, err := handler(ctx, req)
 
-		userIP := getMetadataValue(md, "User-Real-IP")
-		if userIP == "" {
-			userIP = getMetadataValue(md, "X-Forwarded-For")
-		}
-		userAgent := getMetadataValue(md, "User-Real-Agent")
-		if userAgent == "" {
-			userAgent = getMetadataValue(md, "User-Agent")
-		}
+		LogAccess(ctx, info.FullMethod, req, startTime, err)
 
-		clientIP := ""
-		// 获取客户端IP地址
-		p, ok := peer.FromContext(ctx)
-		if ok {
-			clientIP, _, _ = net.SplitHostPort(p.Addr.String())
-		}
+		return resp, errno.HandleError(err)
+	}
+}
 
-		if !validateClient(clientID, clientSecret) {
-			err = errors.New("invalid client secret")
+// GrpcLoggerStreamInterceptor gRPC流式访问日志拦截器
+// 流结束后记录日志, 不记录请求参数
+// 返回:
+//
+//	grpc.StreamServerInterceptor - 流拦截器
+func GrpcLoggerStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if IsHealthMethod(info.FullMethod) {
+			return handler(srv, ss)
 		}
 
 		startTime := time.Now()
+		err := handler(srv, ss)
 
-		if err == nil {
-			resp, err = handler(ctx, req)
-		}
+		LogAccess(ss.Context(), info.FullMethod, nil, startTime, err)
 
-		if err != nil {
-			err = errno.HandleError(err)
-		}
+		return errno.HandleError(err)
+	}
+}
 
-		endTime := time.Now()
-
-		latency := int(endTime.Sub(startTime).Milliseconds())
-		params, _ := json.Marshal(req)
-		url := info.FullMethod
-		st, _ := status.FromError(err)
-
-		timestamp, _ := time.Parse("2006-01-02 15:04:05", endTime.Format("2006-01-02 15:04:05"))
-		logEntry := LogEntry{
-			Service:    "grpc",
-			Method:     url,
-			ClientID:   clientID,
-			ClientIP:   clientIP,
-			UserIP:     userIP,
-			UserAgent:  userAgent,
-			StatusCode: int(st.Code()),
-			Latency:    latency,
-			Timestamp:  timestamp,
-			Error:      st.Message(),
-			Params:     string(params),
-		}
+// LogAccess 记录一次gRPC访问日志
+// 参数:
+//
+//	ctx - 请求上下文, 认证通过时客户端ID取调用方身份, 否则取metadata中的 Client-ID
+//	fullMethod - 调用的gRPC方法
+//	req - 请求参数, 为nil时不记录
+//	startTime - 开始处理的时间
+//	err - 处理结果, 状态码按 errno.HandleError 转换, 错误信息包含内部原因
+func LogAccess(ctx context.Context, fullMethod string, req interface{}, startTime time.Time, err error) {
+	clientID, clientIP, userIP, userAgent := requestInfo(ctx)
+
+	endTime := time.Now()
+	latency := int(endTime.Sub(startTime).Milliseconds())
+	var params []byte
+	if req != nil {
+		params, _ = json.Marshal(req)
+	}
+
+	timestamp, _ := time.Parse("2006-01-02 15:04:05", endTime.Format("2006-01-02 15:04:05"))
+	logEntry := LogEntry{
+		Service:    "grpc",
+		Method:     fullMethod,
+		RequestID:  RequestIDFromContext(ctx),
+		ClientID:   clientID,
+		ClientIP:   clientIP,
+		UserIP:     userIP,
+		UserAgent:  userAgent,
+		StatusCode: int(status.Code(errno.HandleError(err))),
+		Latency:    latency,
+		Timestamp:  timestamp,
+		Error:      errno.Detail(err),
+		Params:     string(params),
+	}
+
+	LogAsync(logEntry)
+}
 
-		LogAsync(logEntry)
+// IsHealthMethod 是否为 grpc.health.v1 健康检查接口, 供负载均衡及探针匿名调用, 不认证也不记录日志
+func IsHealthMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/grpc.health.v1.Health/")
+}
 
-		return resp, err
+// requestInfo 从metadata及连接中读取客户端信息
+// 参数:
+//
+//	ctx - 请求上下文
+//
+// 返回:
+//
+//	clientID, clientIP, userIP, userAgent - 客户端信息, 认证通过时 clientID 取身份中的客户端ID
+func requestInfo(ctx context.Context) (clientID, clientIP, userIP, userAgent string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	clientID = ClientIDFromContext(ctx)
+	if clientID == "" {
+		clientID = getMetadataValue(md, "Client-ID")
+	}
+
+	userIP = getMetadataValue(md, "User-Real-IP")
+	if userIP == "" {
+		userIP = getMetadataValue(md, "X-Forwarded-For")
+	}
+	userAgent = getMetadataValue(md, "User-Real-Agent")
+	if userAgent == "" {
+		userAgent = getMetadataValue(md, "User-Agent")
 	}
+
+	// 获取客户端IP地址
+	if p, ok := peer.FromContext(ctx); ok {
+		clientIP, _, _ = net.SplitHostPort(p.Addr.String())
+	}
+	return
+}
+
+// ClientIDFromContext 从上下文中获取已验证的客户端ID
+func ClientIDFromContext(ctx context.Context) string {
+	if identity := auth.IdentityFromContext(ctx); identity != nil {
+		return identity.ClientID
+	}
+	return ""
 }
 
 // getMetadataValue 获取metadata值
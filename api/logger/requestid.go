@@ -0,0 +1,31 @@
+package logger
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// RequestIDKey 请求ID的metadata键, 客户端未传时由服务端生成, 并在响应头中返回
+const RequestIDKey = "x-request-id"
+
+// requestIDKey 上下文中请求ID的键
+type requestIDKey struct{}
+
+// WithRequestID 将请求ID写入上下文
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext 从上下文中获取请求ID
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// NewRequestID 生成16字节随机请求ID
+func NewRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
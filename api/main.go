@@ -1,28 +1,55 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"easyms-es/api/auth"
+	"easyms-es/api/gateway"
+	"easyms-es/api/health"
 	"easyms-es/api/logger"
+	"easyms-es/api/ratelimit"
 	"easyms-es/api/router"
+	"easyms-es/metrics"
 	"easyms-es/model"
+	pb "easyms-es/protos/services"
+	"easyms-es/service/admin"
+	"easyms-es/service/currency"
 	"easyms-es/service/prices"
 	"easyms-es/service/products"
+	"easyms-es/tracing"
+	"errors"
+	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 	"log"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
 	"runtime"
+	"sync"
+	"syscall"
 	"time"
 )
 
 import (
 	"easyms-es/config"
+	"easyms-es/db"
 	"easyms-es/easyes"
 )
 
 var (
 	appName string = "api"
+
+	// 各监听器的认证方式, 由 common.auth.listeners 配置
+	tcpAuthMode     = auth.ModeSecret
+	gatewayAuthMode = auth.ModeSecret
+	quicAuthMode    = auth.ModeSecret
+
+	// 停机时导出剩余的span
+	shutdownTracing = func(context.Context) error { return nil }
 )
 
 // init 初始化应用配置和核心组件
@@ -31,14 +58,77 @@ func init() {
 	// 初始化配置系统
 	config.InitConfig(appName)
 
+	// 链路追踪, 未配置导出方式时只传播 trace context
+	var tracingConfig tracing.Config
+	if _, err := config.UnmarshalAppConfigKey("common.tracing", &tracingConfig); err != nil {
+		log.Fatalf("failed to get config value: %s, %v", "common.tracing", err)
+	}
+	if len(tracingConfig.ServiceName) == 0 {
+		tracingConfig.ServiceName = "easyes-" + appName
+	}
+	shutdown, err := tracing.Init(context.Background(), tracingConfig)
+	if err != nil {
+		log.Fatalf("failed to init tracing: %v", err)
+	}
+	shutdownTracing = shutdown
+
 	// 读取服务器证书路径和日志数据库连接字符串
-	logConnString, exit := config.GetAppConfigValue[string]("common.logdb.connstring")
-	if exit {
+	logConnString, ok := config.GetAppConfigValue[string]("common.logdb.connstring")
+	if !ok {
 		log.Fatalf("failed to get config value: %s", "common.logdb.connstring")
 	}
 
+	// 客户端注册表
+	clientsFile, exit := config.GetAppConfigValue[string]("common.auth.clientsfile")
+	if !exit {
+		log.Fatalf("failed to get config value: %s", "common.auth.clientsfile")
+	}
+	clientsReload := 30
+	if v, ok := config.GetAppConfigValue[int]("common.auth.reload"); ok {
+		clientsReload = *v
+	}
+	if err := auth.InitClients(*clientsFile, time.Duration(clientsReload)*time.Second); err != nil {
+		log.Fatalf("failed to load clients: %v", err)
+	}
+
+	// Bearer 令牌, 未配置 JWKS 时不接受令牌
+	if jwksFile, ok := config.GetAppConfigValue[string]("common.auth.jwt.jwksfile"); ok {
+		jwtConfig := auth.JWTConfig{JWKSFile: *jwksFile, Reload: time.Duration(clientsReload) * time.Second}
+		if issuer, ok := config.GetAppConfigValue[string]("common.auth.jwt.issuer"); ok {
+			jwtConfig.Issuer = *issuer
+		}
+		if audience, ok := config.GetAppConfigValue[string]("common.auth.jwt.audience"); ok {
+			jwtConfig.Audience = *audience
+		}
+		if claim, ok := config.GetAppConfigValue[string]("common.auth.jwt.clientclaim"); ok {
+			jwtConfig.ClientClaim = *claim
+		}
+		if leeway, ok := config.GetAppConfigValue[int]("common.auth.jwt.leeway"); ok {
+			jwtConfig.Leeway = time.Duration(*leeway) * time.Second
+		}
+		if reload, ok := config.GetAppConfigValue[int]("common.auth.jwt.reload"); ok {
+			jwtConfig.Reload = time.Duration(*reload) * time.Second
+		}
+		if scopes, ok := config.GetAppConfigStringMapSlice("common.auth.jwt.scopes"); ok {
+			jwtConfig.ScopeMethods = scopes
+		}
+		if err := auth.InitJWT(jwtConfig); err != nil {
+			log.Fatalf("failed to load jwks: %v", err)
+		}
+	}
+
+	// 客户端证书身份映射, 未配置时不接受证书认证
+	if certsFile, ok := config.GetAppConfigValue[string]("common.auth.certsfile"); ok {
+		if err := auth.InitCerts(*certsFile, time.Duration(clientsReload)*time.Second); err != nil {
+			log.Fatalf("failed to load certs: %v", err)
+		}
+	}
+	tcpAuthMode = authMode("common.auth.listeners.tcp")
+	gatewayAuthMode = authMode("common.auth.listeners.gateway")
+	quicAuthMode = authMode("common.auth.listeners.quic")
+
 	// 使用数据库连接初始化日志系统
-	err := logger.InitLogger(*logConnString)
+	err = logger.InitLogger(*logConnString)
 
 	if err != nil {
 		log.Fatalf(err.Error())
@@ -62,6 +152,11 @@ func init() {
 		log.Fatalf(err.Error())
 	}
 	products.ProductStore = *store1
+
+	// 搜索字段权重
+	if boots, ok := config.GetAppConfigIntSlice("common.elasticsearch.boots"); ok {
+		products.FieldBoots = boots
+	}
 	store2, err := easyes.NewStore(easyes.StoreConfig{
 		IndexName: model.EsPriceIndexName,
 		Timeout:   time.Duration(*timeout) * time.Second,
@@ -70,37 +165,159 @@ func init() {
 		log.Fatalf(err.Error())
 	}
 	prices.PriceStore = *store2
+
+	// 实时写入的默认刷新策略
+	if refresh, ok := config.GetAppConfigValue[string]("common.admin.refresh"); ok {
+		admin.Refresh = *refresh
+	}
+
+	// redis 可选, 用于分类聚合及首字母索引缓存、异步搜索归属、多实例限流计数, 未配置或连接失败时不启用
+	if _, ok := config.GetAppConfigValue[string]("common.redis.address"); ok {
+		if err := db.InitRedis(); err != nil {
+			log.Printf("redis is disabled: %v", err)
+		}
+	}
+	if ttl, ok := config.GetAppConfigValue[int]("common.redis.cachettl"); ok && *ttl > 0 {
+		products.CacheTTL = time.Duration(*ttl) * time.Second
+	}
+
+	// 按客户端及方法限流, 未配置规则时不限流
+	var rateRules []ratelimit.Rule
+	if ok, err := config.UnmarshalAppConfigKey("common.ratelimit.rules", &rateRules); err != nil {
+		log.Fatalf("failed to get config value: %s, %v", "common.ratelimit.rules", err)
+	} else if ok && len(rateRules) > 0 {
+		var store ratelimit.Store = ratelimit.NewMemoryStore()
+		if backend, ok := config.GetAppConfigValue[string]("common.ratelimit.store"); ok && *backend == "redis" {
+			// 多实例共享的配额依赖redis, 启动时不可用则退出, 避免各实例按进程内计数放大配额
+			if db.EasyRedis == nil {
+				log.Fatalf("ratelimit store is redis, but redis is not available")
+			}
+			store = ratelimit.NewRedisStore(db.EasyRedis)
+		}
+		limiter, err := ratelimit.NewLimiter(rateRules, store)
+		if err != nil {
+			log.Fatalf(err.Error())
+		}
+		ratelimit.Limiters = limiter
+	}
+
+	// 汇率表, 未配置时不支持币种换算
+	if source, ok := config.GetAppConfigValue[string]("common.currency.source"); ok {
+		sourceConfig := currency.SourceConfig{Source: *source}
+		if file, ok := config.GetAppConfigValue[string]("common.currency.file"); ok {
+			sourceConfig.File = *file
+		}
+		if connString, ok := config.GetAppConfigValue[string]("common.currency.connstring"); ok {
+			sourceConfig.ConnString = *connString
+		}
+		if table, ok := config.GetAppConfigValue[string]("common.currency.table"); ok {
+			sourceConfig.Table = *table
+		}
+		if scale, ok := config.GetAppConfigValue[int]("common.currency.scale"); ok {
+			currency.DefaultScale = *scale
+		}
+		if err := currency.Init(sourceConfig); err != nil {
+			log.Printf("failed to load currency rates: %v", err)
+		}
+	}
+}
+
+// serverCredentials TCP 服务的TLS凭证, 配置 common.server.clientca 时校验客户端提供的证书, 用于证书认证
+func serverCredentials(certFile string, keyFile string) (credentials.TransportCredentials, error) {
+	clientCA, ok := config.GetAppConfigValue[string]("common.server.clientca")
+	if !ok {
+		return credentials.NewServerTLSFromFile(certFile, keyFile)
+	}
+
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	ca, err := os.ReadFile(*clientCA)
+	if err != nil {
+		return nil, err
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(ca) {
+		return nil, errors.New("failed to append client CA certificate")
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		ClientCAs:    certPool,
+	}), nil
+}
+
+// authMode 读取监听器的认证方式, 未配置时为 secret
+func authMode(key string) auth.Mode {
+	value, ok := config.GetAppConfigValue[string](key)
+	if !ok {
+		return auth.ModeSecret
+	}
+	mode, err := auth.ParseMode(*value)
+	if err != nil {
+		log.Fatalf("failed to get config value: %s, %v", key, err)
+	}
+	return mode
 }
 
 // main 函数启动微服务
 // 启动HTTP/2和HTTP/3服务并注册gRPC接口
 func main() {
-	// 启动pprof性能监控
+	// 启动pprof性能监控, 同端口提供HTTP健康探针及 Prometheus 指标
 	runtime.SetBlockProfileRate(1)
+	http.HandleFunc("/healthz", health.Healthz)
+	http.HandleFunc("/readyz", health.Readyz)
+	http.Handle("/metrics", metrics.Handler())
 	go func() {
 		log.Printf(http.ListenAndServe(":6060", nil).Error())
 	}()
 
-	certFilePath, exit := config.GetAppConfigValue[string]("common.server.cert")
-	if exit {
+	// 汇率定时刷新
+	if spec, ok := config.GetAppConfigValue[string]("common.currency.cron"); ok {
+		rateCron, err := currency.StartRefresh(*spec)
+		if err != nil {
+			log.Fatalf("failed to start currency refresh: %v", err)
+		}
+		defer rateCron.Stop()
+	}
+
+	// 依赖健康检查, Elasticsearch 为关键依赖, 日志库及redis不可用时服务降级运行
+	stopHealth := startHealth()
+	defer stopHealth()
+
+	certFilePath, ok := config.GetAppConfigValue[string]("common.server.cert")
+	if !ok {
 		log.Fatalf("failed to get config value: %s", "common.server.cert")
 	}
-	keyFilePath, exit := config.GetAppConfigValue[string]("common.server.key")
-	if exit {
+	keyFilePath, ok := config.GetAppConfigValue[string]("common.server.key")
+	if !ok {
 		log.Fatalf("failed to get config value: %s", "common.server.key")
 	}
 
+	// 停机信号, 收到后依次停止网关、gRPC服务并写入剩余日志
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	shutdownTimeout := 30 * time.Second
+	if v, ok := config.GetAppConfigValue[int]("common.server.shutdowntimeout"); ok && *v > 0 {
+		shutdownTimeout = time.Duration(*v) * time.Second
+	}
+
 	//http3 + grpc init 注意尚未解决net6客户端兼容问题,
+	var quicWg sync.WaitGroup
+	quicWg.Add(1)
 	go func() {
-		err := router.EasyGrpcQUICServer("grpc.easy.bom:50051", "./certs/server.crt", "./certs/server.key")
+		defer quicWg.Done()
+		err := router.EasyGrpcQUICServer(ctx, "grpc.easy.bom:50051", "./certs/server.crt", "./certs/server.key", shutdownTimeout, quicAuthMode)
 		if err != nil {
 			log.Printf("failed to Echo QUIC Server. %s", err.Error())
 			return
 		}
-		log.Printf("gRPC server listening at %s", "grpc.easy.bom:50051")
+		log.Printf("QUIC server stopped at %s", "grpc.easy.bom:50051")
 	}()
 
-	cert, err := credentials.NewServerTLSFromFile(*certFilePath, *keyFilePath)
+	cert, err := serverCredentials(*certFilePath, *keyFilePath)
 	if err != nil {
 		log.Fatalf("failed to load TLS certificates: %v", err)
 	}
@@ -113,12 +330,17 @@ func main() {
 	}
 
 	//grpc init
-	grpcServer := grpc.NewServer(
-		grpc.Creds(cert),
-		grpc.KeepaliveParams(keepAliveArgs),
-		grpc.UnaryInterceptor(logger.GrpcLoggerUnaryInterceptor()),
-	)
-	router.InitGrpc(grpcServer)
+	grpcServer := router.NewServer(tcpAuthMode, grpc.Creds(cert), grpc.KeepaliveParams(keepAliveArgs))
+
+	// REST网关及Swagger, 未配置地址时不启动
+	stopGateway := func(context.Context) {}
+	if gatewayAddr, ok := config.GetAppConfigValue[string]("common.gateway.addr"); ok {
+		stopGateway, err = serveGateway(*gatewayAddr, *certFilePath, *keyFilePath, shutdownTimeout)
+		if err != nil {
+			log.Fatalf("failed to start gateway: %v", err)
+		}
+	}
+
 	listen, err := net.Listen("tcp", "grpc.easy.bom:50052")
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
@@ -126,9 +348,105 @@ func main() {
 
 	log.Printf("gRPC server listening at %v", listen.Addr())
 
-	if err := grpcServer.Serve(listen); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- grpcServer.Serve(listen)
+	}()
+
+	select {
+	case <-ctx.Done():
+		log.Printf("shutting down, waiting up to %v for in-flight requests", shutdownTimeout)
+	case err := <-serveErr:
+		log.Printf("failed to serve: %v", err)
+		stop()
 	}
 
+	// 探针先返回未就绪, 负载均衡不再分发新请求
+	health.Shutdown()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	stopGateway(shutdownCtx)
+	router.GracefulStop(grpcServer, shutdownTimeout)
+	quicWg.Wait()
+
 	logger.CloseLogging()
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.Printf("failed to shutdown tracing: %v", err)
+	}
+	log.Printf("server stopped")
+}
+
+// serveGateway 启动REST网关, 通过进程内连接调用gRPC服务, 与TCP服务使用相同的拦截器, 返回停止函数
+func serveGateway(addr string, certFile string, keyFile string, shutdownTimeout time.Duration) (func(context.Context), error) {
+	if runMode, ok := config.GetAppConfigValue[string]("common.server.runmode"); ok {
+		gin.SetMode(*runMode)
+	}
+
+	inProcessServer := router.NewServer(gatewayAuthMode)
+
+	conn, err := gateway.ServeInProcess(inProcessServer)
+	if err != nil {
+		return nil, err
+	}
+	gatewayHandler, err := gateway.NewHandler(context.Background(), conn)
+	if err != nil {
+		return nil, err
+	}
+	handler := http.NewServeMux()
+	handler.HandleFunc("/healthz", health.Healthz)
+	handler.HandleFunc("/readyz", health.Readyz)
+	handler.Handle("/", gatewayHandler)
+
+	server := &http.Server{Addr: addr, Handler: handler}
+	isTls, ok := config.GetAppConfigValue[bool]("common.gateway.tls")
+	go func() {
+		var err error
+		if ok && *isTls {
+			log.Printf("REST gateway listening at %s", "https://"+addr)
+			err = server.ListenAndServeTLS(certFile, keyFile)
+		} else {
+			log.Printf("REST gateway listening at %s", "http://"+addr)
+			err = server.ListenAndServe()
+		}
+		if !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("failed to serve gateway: %v", err)
+		}
+	}()
+
+	// 先停止HTTP接收新请求, 再停止进程内gRPC服务
+	return func(ctx context.Context) {
+		if err := server.Shutdown(ctx); err != nil {
+			log.Printf("failed to shutdown gateway: %v", err)
+		}
+		router.GracefulStop(inProcessServer, shutdownTimeout)
+		_ = conn.Close()
+	}, nil
+}
+
+// startHealth 注册依赖检查并启动后台检查
+func startHealth() func() {
+	productServices := []string{
+		pb.ProductsSearchService_ServiceDesc.ServiceName,
+		pb.BomService_ServiceDesc.ServiceName,
+		pb.AdminService_ServiceDesc.ServiceName,
+	}
+	priceServices := []string{
+		pb.PriceSearchService_ServiceDesc.ServiceName,
+		pb.BomService_ServiceDesc.ServiceName,
+		pb.AdminService_ServiceDesc.ServiceName,
+	}
+	health.Register(health.Check{Name: "elasticsearch:" + model.EsProductIndexName, Critical: true, Services: productServices, Probe: products.ProductStore.Health})
+	health.Register(health.Check{Name: "elasticsearch:" + model.EsPriceIndexName, Critical: true, Services: priceServices, Probe: prices.PriceStore.Health})
+	health.Register(health.Check{Name: "logdb", Probe: logger.Ping})
+	health.Register(health.Check{Name: "redis", Probe: db.PingRedis})
+
+	interval, timeout := 10, 3
+	if v, ok := config.GetAppConfigValue[int]("common.health.interval"); ok && *v > 0 {
+		interval = *v
+	}
+	if v, ok := config.GetAppConfigValue[int]("common.health.timeout"); ok && *v > 0 {
+		timeout = *v
+	}
+	return health.Start(time.Duration(interval)*time.Second, time.Duration(timeout)*time.Second, router.ServiceNames())
 }
@@ -0,0 +1,167 @@
+// Package ratelimit 按客户端及方法限流, 令牌桶限制请求速率, 每日配额限制请求总量
+package ratelimit
+
+import (
+	"context"
+	"easyms-es/api/auth"
+	"easyms-es/api/logger"
+	"easyms-es/errno"
+	"easyms-es/metrics"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RetryAfterKey 限流时返回的metadata, 值为建议的重试等待秒数
+const RetryAfterKey = "retry-after"
+
+// Rule 限流规则, Client 为客户端ID或 *, Method 支持 /services.PriceSearchService/* 通配
+// Rate 为每秒补充的令牌数, Burst 为令牌桶容量, Daily 为每日配额, 为0时不限制对应项
+// 计数按 客户端ID + 规则的 Method 累计, 通配规则下的方法共享同一计数
+type Rule struct {
+	Client string  `mapstructure:"client"`
+	Method string  `mapstructure:"method"`
+	Rate   float64 `mapstructure:"rate"`
+	Burst  int     `mapstructure:"burst"`
+	Daily  int64   `mapstructure:"daily"`
+}
+
+// Store 限流计数存储
+type Store interface {
+	// Take 从令牌桶取一个令牌, 令牌不足时返回需要等待的时间, 出错时也可返回降级的结果
+	Take(ctx context.Context, key string, rate float64, burst int) (time.Duration, error)
+	// Incr 累加当日请求数, 返回累加后的数量
+	Incr(ctx context.Context, key string, day string) (int64, error)
+}
+
+// Limiter 限流器, 按配置顺序取第一条匹配的规则
+type Limiter struct {
+	rules []Rule
+	store Store
+}
+
+// NewLimiter 创建限流器
+func NewLimiter(rules []Rule, store Store) (*Limiter, error) {
+	for i, rule := range rules {
+		if len(rule.Method) == 0 {
+			return nil, fmt.Errorf("ratelimit rule %d: method is required", i)
+		}
+		if rule.Rate < 0 || rule.Burst < 0 || rule.Daily < 0 {
+			return nil, fmt.Errorf("ratelimit rule %d: rate, burst and daily can not be negative", i)
+		}
+		if rule.Rate > 0 && rule.Burst == 0 {
+			rules[i].Burst = int(math.Ceil(rule.Rate))
+		}
+	}
+	return &Limiter{rules: rules, store: store}, nil
+}
+
+// Limiters 全局限流器, 未配置规则时为nil, 不限流
+var Limiters *Limiter
+
+// match 查找客户端及方法对应的规则
+func (l *Limiter) match(clientID string, fullMethod string) (Rule, bool) {
+	for _, rule := range l.rules {
+		if rule.Client != "*" && rule.Client != clientID {
+			continue
+		}
+		if auth.MatchMethod([]string{rule.Method}, fullMethod) {
+			return rule, true
+		}
+	}
+	return Rule{}, false
+}
+
+// Allow 检查是否允许本次请求, 拒绝时返回建议的重试等待时间
+// 计数存储出错时仍按存储返回的结果(如redis的进程内降级计数)判断, 错误只用于记录
+func (l *Limiter) Allow(ctx context.Context, clientID string, fullMethod string) (bool, time.Duration, error) {
+	rule, ok := l.match(clientID, fullMethod)
+	if !ok {
+		return true, 0, nil
+	}
+	key := clientID + ":" + rule.Method
+
+	var storeErr error
+	if rule.Rate > 0 {
+		wait, err := l.store.Take(ctx, "bucket:"+key, rule.Rate, rule.Burst)
+		if err != nil {
+			storeErr = err
+		}
+		if wait > 0 {
+			return false, wait, storeErr
+		}
+	}
+
+	if rule.Daily > 0 {
+		now := time.Now()
+		count, err := l.store.Incr(ctx, "quota:"+key, now.Format("20060102"))
+		if err != nil {
+			storeErr = err
+		}
+		if count > rule.Daily {
+			tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
+			return false, tomorrow.Sub(now), storeErr
+		}
+	}
+	return true, 0, storeErr
+}
+
+// check 限流检查, 拒绝时返回 ResourceExhausted 及重试等待秒数(metadata 及 RetryInfo)
+func check(ctx context.Context, fullMethod string, setHeader func(metadata.MD) error) error {
+	if Limiters == nil {
+		return nil
+	}
+	identity := auth.IdentityFromContext(ctx)
+	if identity == nil {
+		return nil
+	}
+
+	allowed, wait, err := Limiters.Allow(ctx, identity.ClientID, fullMethod)
+	if err != nil {
+		log.Printf("ratelimit: failed to check %s %s: %v", identity.ClientID, fullMethod, err)
+	}
+	if allowed {
+		return nil
+	}
+
+	retryAfter := int64(math.Ceil(wait.Seconds()))
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	_ = setHeader(metadata.Pairs(RetryAfterKey, strconv.FormatInt(retryAfter, 10)))
+	return errno.RateLimited(fmt.Sprintf("rate limit exceeded: %s %s, retry after %ds", identity.ClientID, fullMethod, retryAfter), time.Duration(retryAfter)*time.Second)
+}
+
+// UnaryInterceptor 一元限流拦截器, 需在认证拦截器之后执行, 以便读取调用方身份, 拒绝的请求记录访问日志及指标
+func UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		startTime := time.Now()
+		err := check(ctx, info.FullMethod, func(md metadata.MD) error {
+			return grpc.SetHeader(ctx, md)
+		})
+		if err != nil {
+			logger.LogAccess(ctx, info.FullMethod, req, startTime, err)
+			metrics.ObserveGrpc(info.FullMethod, err, startTime)
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor 流式限流拦截器, 每个流计一次请求
+func StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		startTime := time.Now()
+		if err := check(ss.Context(), info.FullMethod, ss.SetHeader); err != nil {
+			logger.LogAccess(ss.Context(), info.FullMethod, nil, startTime, err)
+			metrics.ObserveGrpc(info.FullMethod, err, startTime)
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
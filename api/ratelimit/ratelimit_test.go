@@ -0,0 +1,272 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// clock 可调整的测试时钟
+type clock struct {
+	t time.Time
+}
+
+func (c *clock) now() time.Time {
+	return c.t
+}
+
+func (c *clock) advance(d time.Duration) {
+	c.t = c.t.Add(d)
+}
+
+func newTestStore() (*MemoryStore, *clock) {
+	c := &clock{t: time.Date(2024, 8, 1, 8, 0, 0, 0, time.UTC)}
+	s := NewMemoryStore()
+	s.now = c.now
+	s.pruned = c.t
+	return s, c
+}
+
+func TestMemoryStoreTake(t *testing.T) {
+	ctx := context.Background()
+
+	type step struct {
+		advance time.Duration
+		want    time.Duration // 需要等待的时间, 0 为取到令牌
+	}
+	tests := []struct {
+		name  string
+		rate  float64
+		burst int
+		steps []step
+	}{
+		{
+			name:  "burst then wait",
+			rate:  2,
+			burst: 3,
+			steps: []step{{}, {}, {}, {want: 500 * time.Millisecond}, {want: 500 * time.Millisecond}},
+		},
+		{
+			name:  "partial refill",
+			rate:  2,
+			burst: 1,
+			steps: []step{{}, {advance: 250 * time.Millisecond, want: 250 * time.Millisecond}, {advance: 250 * time.Millisecond}},
+		},
+		{
+			name:  "refill capped at burst",
+			rate:  10,
+			burst: 2,
+			steps: []step{{}, {}, {advance: time.Hour}, {}, {want: 100 * time.Millisecond}},
+		},
+		{
+			name:  "slow rate",
+			rate:  0.5,
+			burst: 1,
+			steps: []step{{}, {advance: time.Second, want: time.Second}, {advance: time.Second}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, c := newTestStore()
+			for i, st := range tt.steps {
+				c.advance(st.advance)
+				wait, err := s.Take(ctx, "bucket:easy:/services.PriceSearchService/*", tt.rate, tt.burst)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if wait != st.want {
+					t.Fatalf("step %d: Take() wait = %v, want %v", i, wait, st.want)
+				}
+			}
+		})
+	}
+}
+
+func TestMemoryStoreBucketsAreSeparate(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStore()
+	if wait, _ := s.Take(ctx, "a", 1, 1); wait != 0 {
+		t.Fatalf("Take(a) wait = %v", wait)
+	}
+	if wait, _ := s.Take(ctx, "a", 1, 1); wait == 0 {
+		t.Fatal("Take(a) did not wait after the bucket was empty")
+	}
+	if wait, _ := s.Take(ctx, "b", 1, 1); wait != 0 {
+		t.Fatalf("Take(b) wait = %v", wait)
+	}
+}
+
+func TestMemoryStorePrune(t *testing.T) {
+	ctx := context.Background()
+	s, c := newTestStore()
+	_, _ = s.Take(ctx, "idle", 1, 5)
+	c.advance(memoryIdleTime + time.Second)
+	_, _ = s.Take(ctx, "active", 1, 5)
+
+	if _, ok := s.buckets["idle"]; ok {
+		t.Fatal("idle bucket was not pruned")
+	}
+	if _, ok := s.buckets["active"]; !ok {
+		t.Fatal("active bucket was pruned")
+	}
+}
+
+func TestMemoryStoreIncr(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStore()
+
+	steps := []struct {
+		key  string
+		day  string
+		want int64
+	}{
+		{key: "a", day: "20240801", want: 1},
+		{key: "a", day: "20240801", want: 2},
+		{key: "b", day: "20240801", want: 1},
+		{key: "a", day: "20240802", want: 1}, // 跨天清空
+		{key: "b", day: "20240802", want: 1},
+		{key: "a", day: "20240802", want: 2},
+	}
+	for i, st := range steps {
+		count, err := s.Incr(ctx, st.key, st.day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != st.want {
+			t.Fatalf("step %d: Incr(%s, %s) = %d, want %d", i, st.key, st.day, count, st.want)
+		}
+	}
+}
+
+func TestNewLimiter(t *testing.T) {
+	tests := []struct {
+		name      string
+		rule      Rule
+		wantBurst int
+		wantErr   bool
+	}{
+		{name: "burst defaults to rate", rule: Rule{Client: "*", Method: "*", Rate: 2.5}, wantBurst: 3},
+		{name: "explicit burst", rule: Rule{Client: "*", Method: "*", Rate: 2, Burst: 10}, wantBurst: 10},
+		{name: "quota only", rule: Rule{Client: "*", Method: "*", Daily: 100}},
+		{name: "missing method", rule: Rule{Client: "*", Rate: 1}, wantErr: true},
+		{name: "negative rate", rule: Rule{Client: "*", Method: "*", Rate: -1}, wantErr: true},
+		{name: "negative daily", rule: Rule{Client: "*", Method: "*", Daily: -1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter, err := NewLimiter([]Rule{tt.rule}, NewMemoryStore())
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("NewLimiter() want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if limiter.rules[0].Burst != tt.wantBurst {
+				t.Fatalf("NewLimiter() burst = %d, want %d", limiter.rules[0].Burst, tt.wantBurst)
+			}
+		})
+	}
+}
+
+func TestLimiterAllow(t *testing.T) {
+	ctx := context.Background()
+	const search = "/services.PriceSearchService/SearchPrices"
+	const quote = "/services.PriceSearchService/QuotePrices"
+	rules := []Rule{
+		{Client: "vip", Method: "*"},
+		{Client: "*", Method: "/services.PriceSearchService/*", Rate: 1, Burst: 2},
+		{Client: "*", Method: "/services.BomService/MatchBOM", Daily: 2},
+	}
+
+	tests := []struct {
+		name   string
+		calls  []string // 依次调用的 clientID:method
+		client string
+		want   []bool
+	}{
+		{name: "no matching rule", client: "easy", calls: []string{"/services.ProductsSearchService/SearchProducts", "/services.ProductsSearchService/SearchProducts"}, want: []bool{true, true}},
+		{name: "first matching rule wins", client: "vip", calls: []string{search, search, search}, want: []bool{true, true, true}},
+		{name: "burst", client: "easy", calls: []string{search, search, search}, want: []bool{true, true, false}},
+		{name: "wildcard methods share a bucket", client: "easy", calls: []string{search, quote, search}, want: []bool{true, true, false}},
+		{name: "daily quota", client: "easy", calls: []string{"/services.BomService/MatchBOM", "/services.BomService/MatchBOM", "/services.BomService/MatchBOM"}, want: []bool{true, true, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter, err := NewLimiter(append([]Rule(nil), rules...), NewMemoryStore())
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, method := range tt.calls {
+				allowed, wait, err := limiter.Allow(ctx, tt.client, method)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if allowed != tt.want[i] {
+					t.Fatalf("call %d: Allow(%s, %s) = %v, want %v", i, tt.client, method, allowed, tt.want[i])
+				}
+				if !allowed && (wait <= 0 || wait > 24*time.Hour) {
+					t.Fatalf("call %d: Allow() wait = %v", i, wait)
+				}
+			}
+		})
+	}
+
+	// 不同客户端分别计数
+	limiter, _ := NewLimiter(append([]Rule(nil), rules...), NewMemoryStore())
+	for _, client := range []string{"a", "a", "b", "b"} {
+		if allowed, _, _ := limiter.Allow(ctx, client, search); !allowed {
+			t.Fatalf("Allow(%s) was rejected by another client's bucket", client)
+		}
+	}
+}
+
+// failingStore 模拟redis失败时返回降级结果及错误
+type failingStore struct {
+	wait  time.Duration
+	count int64
+}
+
+var errStore = errors.New("redis is down")
+
+func (s failingStore) Take(context.Context, string, float64, int) (time.Duration, error) {
+	return s.wait, errStore
+}
+
+func (s failingStore) Incr(context.Context, string, string) (int64, error) {
+	return s.count, errStore
+}
+
+func TestLimiterAllowStoreError(t *testing.T) {
+	ctx := context.Background()
+	rules := []Rule{{Client: "*", Method: "*", Rate: 1, Daily: 10}}
+
+	tests := []struct {
+		name  string
+		store failingStore
+		want  bool
+	}{
+		{name: "fallback allows", store: failingStore{count: 1}, want: true},
+		{name: "fallback bucket empty", store: failingStore{wait: time.Second}, want: false},
+		{name: "fallback quota exceeded", store: failingStore{count: 11}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter, err := NewLimiter(append([]Rule(nil), rules...), tt.store)
+			if err != nil {
+				t.Fatal(err)
+			}
+			allowed, _, err := limiter.Allow(ctx, "easy", "/services.BomService/MatchBOM")
+			if !errors.Is(err, errStore) {
+				t.Fatalf("Allow() error = %v, want store error", err)
+			}
+			if allowed != tt.want {
+				t.Fatalf("Allow() = %v, want %v", allowed, tt.want)
+			}
+		})
+	}
+}
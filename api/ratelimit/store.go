@@ -0,0 +1,147 @@
+package ratelimit
+
+import (
+	"context"
+	"easyms-es/metrics"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	keyPrefix      = "easyes:ratelimit:"
+	quotaExpire    = 48 * time.Hour
+	memoryIdleTime = 10 * time.Minute
+)
+
+// bucket 令牌桶
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// MemoryStore 进程内计数, 多实例部署时各实例分别计数
+type MemoryStore struct {
+	buckets map[string]*bucket
+	quotas  map[string]int64 // key:当日请求数
+	day     string
+	pruned  time.Time
+	now     func() time.Time // 当前时间, 测试时替换
+	mu      sync.Mutex
+}
+
+// NewMemoryStore 创建进程内计数存储
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*bucket),
+		quotas:  make(map[string]int64),
+		pruned:  time.Now(),
+		now:     time.Now,
+	}
+}
+
+// Take 从令牌桶取一个令牌
+func (s *MemoryStore) Take(_ context.Context, key string, rate float64, burst int) (time.Duration, error) {
+	now := s.now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.prune(now)
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(burst), last: now}
+		s.buckets[key] = b
+	}
+	b.tokens = math.Min(float64(burst), b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+	if b.tokens < 1 {
+		return time.Duration((1 - b.tokens) / rate * float64(time.Second)), nil
+	}
+	b.tokens--
+	return 0, nil
+}
+
+// Incr 累加当日请求数, 跨天时清空全部计数
+func (s *MemoryStore) Incr(_ context.Context, key string, day string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.day != day {
+		s.day = day
+		s.quotas = make(map[string]int64)
+	}
+	s.quotas[key]++
+	return s.quotas[key], nil
+}
+
+// prune 清理长时间未使用的令牌桶, 空闲的桶已补满, 删除后重新创建结果相同
+func (s *MemoryStore) prune(now time.Time) {
+	if now.Sub(s.pruned) < memoryIdleTime {
+		return
+	}
+	for key, b := range s.buckets {
+		if now.Sub(b.last) > memoryIdleTime {
+			delete(s.buckets, key)
+		}
+	}
+	s.pruned = now
+}
+
+// takeScript 令牌桶脚本, 使用redis服务器时间, 避免各实例时钟不一致, 返回需要等待的毫秒数
+var takeScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local data = redis.call('HMGET', KEYS[1], 'tokens', 'last')
+local tokens = tonumber(data[1]) or burst
+local last = tonumber(data[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - last) * rate / 1000)
+local wait = 0
+if tokens < 1 then
+  wait = math.ceil((1 - tokens) * 1000 / rate)
+else
+  tokens = tokens - 1
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'last', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return wait
+`)
+
+// RedisStore redis计数, 多实例共享限流计数, redis不可用时使用进程内计数并记录 metrics.RatelimitFallback
+type RedisStore struct {
+	client   *redis.Client
+	fallback *MemoryStore
+}
+
+// NewRedisStore 创建redis计数存储
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{client: client, fallback: NewMemoryStore()}
+}
+
+// Take 从令牌桶取一个令牌
+func (s *RedisStore) Take(ctx context.Context, key string, rate float64, burst int) (time.Duration, error) {
+	wait, err := takeScript.Run(ctx, s.client, []string{keyPrefix + key}, rate, burst).Int64()
+	if err != nil {
+		metrics.RatelimitFallback.WithLabelValues("take").Inc()
+		wait, _ := s.fallback.Take(ctx, key, rate, burst)
+		return wait, err
+	}
+	return time.Duration(wait) * time.Millisecond, nil
+}
+
+// Incr 累加当日请求数, 计数保留两天
+func (s *RedisStore) Incr(ctx context.Context, key string, day string) (int64, error) {
+	redisKey := keyPrefix + key + ":" + day
+	pipe := s.client.TxPipeline()
+	incr := pipe.Incr(ctx, redisKey)
+	pipe.Expire(ctx, redisKey, quotaExpire)
+	if _, err := pipe.Exec(ctx); err != nil {
+		metrics.RatelimitFallback.WithLabelValues("incr").Inc()
+		count, _ := s.fallback.Incr(ctx, key, day)
+		return count, err
+	}
+	return incr.Val(), nil
+}
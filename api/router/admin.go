@@ -0,0 +1,58 @@
+package router
+
+import (
+	"context"
+	"easyms-es/api/dto"
+	"easyms-es/protos/messages"
+	pb "easyms-es/protos/services"
+	"easyms-es/service/admin"
+)
+
+type AdminEsServer struct {
+	pb.AdminServiceServer
+}
+
+// UpsertProducts 写入或覆盖产品
+func (s *AdminEsServer) UpsertProducts(ctx context.Context, req *messages.UpsertProductsParam) (*messages.WriteResult, error) {
+	res, err := admin.UpsertProducts(ctx, dto.MapperToProductSources(req.Products), req.Refresh)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.MapperToWriteResult(&res), nil
+}
+
+// DeleteProducts 删除产品
+func (s *AdminEsServer) DeleteProducts(ctx context.Context, req *messages.DeleteProductsParam) (*messages.WriteResult, error) {
+	res, err := admin.DeleteProducts(ctx, req.PIDs, req.Refresh)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.MapperToWriteResult(&res), nil
+}
+
+// UpsertPrices 写入或覆盖价格
+func (s *AdminEsServer) UpsertPrices(ctx context.Context, req *messages.UpsertPricesParam) (*messages.WriteResult, error) {
+	sources, err := dto.MapperToPriceSources(req.Prices)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := admin.UpsertPrices(ctx, sources, req.Refresh)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.MapperToWriteResult(&res), nil
+}
+
+// DeletePrices 删除价格
+func (s *AdminEsServer) DeletePrices(ctx context.Context, req *messages.DeletePricesParam) (*messages.WriteResult, error) {
+	res, err := admin.DeletePrices(ctx, req.SPIDs, req.Refresh)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.MapperToWriteResult(&res), nil
+}
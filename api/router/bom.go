@@ -0,0 +1,23 @@
+package router
+
+import (
+	"context"
+	"easyms-es/api/dto"
+	"easyms-es/protos/messages"
+	pb "easyms-es/protos/services"
+	"easyms-es/service/bom"
+)
+
+type BomEsServer struct {
+	pb.BomServiceServer
+}
+
+// MatchBOM BOM批量匹配及报价
+func (s *BomEsServer) MatchBOM(ctx context.Context, req *messages.MatchBOMParam) (*messages.MatchBOMResult, error) {
+	res, err := bom.Match(ctx, dto.MapperToBomMatchParam(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.MapperToMatchBOMResult(&res), nil
+}
@@ -1,18 +1,21 @@
 package router
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"easyms-es/api/auth"
 	qnet "easyms-es/api/grpcquic"
-	pb "easyms-es/protos/services"
 	"github.com/quic-go/quic-go"
 	"google.golang.org/grpc"
 	"io/ioutil"
 	"log"
+	"time"
 )
 
-// EasyGrpcQUICServer http3 服务初始化，
-func EasyGrpcQUICServer(addr, certFile, keyFile string) error {
+// EasyGrpcQUICServer http3 服务初始化, ctx 结束时停止接收新连接, 在 shutdownTimeout 内等待请求完成
+// 与TCP服务使用相同的拦截器链, mode 为认证方式, 客户端证书可用于证书认证
+func EasyGrpcQUICServer(ctx context.Context, addr, certFile, keyFile string, shutdownTimeout time.Duration, mode auth.Mode) error {
 	log.Println("starting echo QUICServer")
 
 	// 加载服务端证书和密钥
@@ -48,16 +51,22 @@ func EasyGrpcQUICServer(addr, certFile, keyFile string) error {
 
 	listener := qnet.Listen(*ql)
 
-	s := grpc.NewServer()
-	pb.RegisterProductsSearchServiceServer(s, &ProductEsServer{})
-	pb.RegisterPriceSearchServiceServer(s, &PriceEsServer{})
-	//reflection.Register(s)
+	s := NewServer(mode, grpc.Creds(qnet.NewCredentials(tlsConf)))
 	log.Printf("QUICServer: listening at %v", listener.Addr())
 
+	stopped := make(chan struct{})
+	go func() {
+		<-ctx.Done()
+		GracefulStop(s, shutdownTimeout)
+		close(stopped)
+	}()
+
 	if err := s.Serve(listener); err != nil {
 		log.Printf("QUICServer: failed to serve. %v", err)
 		return err
 	}
+	// Serve 在监听关闭后即返回, 等待停机完成后再返回, 保证进行中的请求已结束
+	<-stopped
 
 	log.Println("stopping echo QUICServer")
 	return nil
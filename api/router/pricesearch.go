@@ -5,7 +5,10 @@ import (
 	"easyms-es/api/dto"
 	"easyms-es/protos/messages"
 	pb "easyms-es/protos/services"
+	"easyms-es/service/models"
 	"easyms-es/service/prices"
+
+	"google.golang.org/grpc"
 )
 
 type PriceEsServer struct {
@@ -14,7 +17,7 @@ type PriceEsServer struct {
 
 // SearchPrices 单产品价格搜索
 func (s *PriceEsServer) SearchPrices(ctx context.Context, req *messages.PriceSearchParam) (*messages.SearchPricesResult, error) {
-	res, err := prices.Search(int(req.PID), int(req.Size), int(req.From))
+	res, err := prices.Search(ctx, dto.MapperToPriceSearchParam(req))
 	if err != nil {
 		return nil, err
 	}
@@ -26,3 +29,35 @@ func (s *PriceEsServer) SearchPrices(ctx context.Context, req *messages.PriceSea
 
 	return results, nil
 }
+
+// SearchPricesByProducts 多产品价格搜索
+func (s *PriceEsServer) SearchPricesByProducts(ctx context.Context, req *messages.ProductsPriceSearchParam) (*messages.SearchPricesByProductsResult, error) {
+	res, err := prices.SearchByProducts(ctx, req.PIDs, int(req.TopSize))
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := dto.MapperToSearchPricesByProductsResult(&res)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// ExportPrices 价格导出, 每批发送完成后才读取下一批
+func (s *PriceEsServer) ExportPrices(req *messages.ExportPricesParam, stream grpc.ServerStreamingServer[messages.ExportPricesChunk]) error {
+	return prices.Export(stream.Context(), dto.MapperToExportPriceParam(req), func(chunk models.ExportPriceChunk) error {
+		return stream.Send(dto.MapperToExportPricesChunk(&chunk))
+	})
+}
+
+// QuotePrices 按数量报价
+func (s *PriceEsServer) QuotePrices(ctx context.Context, req *messages.QuoteParam) (*messages.QuotePricesResult, error) {
+	res, err := prices.Quote(ctx, dto.MapperToQuoteParam(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.MapperToQuotePricesResult(&res), nil
+}
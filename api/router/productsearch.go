@@ -3,9 +3,14 @@ package router
 import (
 	"context"
 	"easyms-es/api/dto"
+	"easyms-es/api/logger"
+	"easyms-es/model"
 	"easyms-es/protos/messages"
 	pb "easyms-es/protos/services"
+	"easyms-es/service/models"
 	"easyms-es/service/products"
+
+	"google.golang.org/grpc"
 )
 
 type ProductEsServer struct {
@@ -14,10 +19,106 @@ type ProductEsServer struct {
 
 // Analyze 商品搜索关键字分析
 func (s *ProductEsServer) Analyze(ctx context.Context, req *messages.ProductSearchParam) (*messages.Tokens, error) {
-	tokens, err := products.Analyze("easy_all", req.KeyWord)
+	tokens, err := products.Analyze(ctx, "easy_all", req.KeyWord)
 	if err != nil {
 		return nil, err
 	}
 	rst := dto.MapperToPdToken(tokens)
 	return rst, nil
 }
+
+// SearchProducts 商品关键词及条件搜索
+func (s *ProductEsServer) SearchProducts(ctx context.Context, req *messages.ProductSearchParam) (*messages.SearchProductsResult, error) {
+	res, err := products.Search(ctx, dto.MapperToProductSearchParam(req))
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := dto.MapperToSearchProductsResult(&res)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// SearchFacets 分面导航聚合
+func (s *ProductEsServer) SearchFacets(ctx context.Context, req *messages.FacetSearchParam) (*messages.FacetsResult, error) {
+	var param model.ProductSearchParam
+	if req.Query != nil {
+		param = dto.MapperToProductSearchParam(req.Query)
+	}
+
+	res, err := products.SearchFacets(ctx, param, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.MapperToFacetsResult(&res), nil
+}
+
+// BrowseProductIndex 型号首字母索引浏览
+func (s *ProductEsServer) BrowseProductIndex(ctx context.Context, req *messages.ProductIndexSearchParam) (*messages.ProductIndexResult, error) {
+	res, err := products.BrowseIndex(ctx, dto.MapperToProductIndexSearchParam(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.MapperToProductIndexResult(&res), nil
+}
+
+// CustomSearchProducts 自定义产品搜索
+func (s *ProductEsServer) CustomSearchProducts(ctx context.Context, req *messages.CustomSearchProductParam) (*messages.SearchProductsResult, error) {
+	res, err := products.CustomSearch(ctx, dto.MapperToCustomSearchProductParam(req))
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := dto.MapperToSearchProductsResult(&res)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// ExportProducts 产品导出, 每批发送完成后才读取下一批
+func (s *ProductEsServer) ExportProducts(req *messages.ExportProductsParam, stream grpc.ServerStreamingServer[messages.ExportProductsChunk]) error {
+	return products.Export(stream.Context(), dto.MapperToExportProductParam(req), func(chunk models.ExportProductChunk) error {
+		return stream.Send(dto.MapperToExportProductsChunk(&chunk))
+	})
+}
+
+// SubmitSearch 提交异步搜索, 任务绑定到当前客户端
+func (s *ProductEsServer) SubmitSearch(ctx context.Context, req *messages.SubmitSearchParam) (*messages.AsyncSearchID, error) {
+	var param model.ProductSearchParam
+	if req.Query != nil {
+		param = dto.MapperToProductSearchParam(req.Query)
+	}
+
+	id, err := products.SubmitSearch(ctx, logger.ClientIDFromContext(ctx), param, req.WithFacets, req.FacetSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &messages.AsyncSearchID{ID: id}, nil
+}
+
+// GetSearchStatus 异步搜索状态及结果
+func (s *ProductEsServer) GetSearchStatus(ctx context.Context, req *messages.AsyncSearchID) (*messages.AsyncSearchStatus, error) {
+	res, err := products.GetSearchStatus(ctx, logger.ClientIDFromContext(ctx), req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.MapperToAsyncSearchStatus(&res)
+}
+
+// CancelSearch 取消异步搜索
+func (s *ProductEsServer) CancelSearch(ctx context.Context, req *messages.AsyncSearchID) (*messages.CancelSearchResult, error) {
+	if err := products.CancelSearch(ctx, logger.ClientIDFromContext(ctx), req.ID); err != nil {
+		return nil, err
+	}
+
+	return &messages.CancelSearchResult{Canceled: true}, nil
+}
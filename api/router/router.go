@@ -1,12 +1,29 @@
 package router
 
 import (
+	"easyms-es/api/auth"
+	"easyms-es/api/health"
+	"easyms-es/api/interceptor"
 	pb "easyms-es/protos/services"
 
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
+// NewServer 创建gRPC服务并注册全部服务, 各监听器使用相同的拦截器链
+// 参数:
+//
+//	mode - 监听器的认证方式
+//	opts - 附加的服务选项, 如TLS凭证及keepalive
+func NewServer(mode auth.Mode, opts ...grpc.ServerOption) *grpc.Server {
+	// 停机超时强制关闭时也等待服务实现返回, 之后才能关闭日志通道
+	opts = append([]grpc.ServerOption{grpc.WaitForHandlers(true)}, opts...)
+	s := grpc.NewServer(append(interceptor.ServerOptions(mode), opts...)...)
+	InitGrpc(s)
+	return s
+}
+
 // InitGrpc 初始化并注册gRPC服务
 // 参数:
 //
@@ -15,6 +32,20 @@ func InitGrpc(grpcServer *grpc.Server) {
 	// 注册产品搜索服务
 	pb.RegisterProductsSearchServiceServer(grpcServer, &ProductEsServer{})
 	pb.RegisterPriceSearchServiceServer(grpcServer, &PriceEsServer{})
+	pb.RegisterBomServiceServer(grpcServer, &BomEsServer{})
+	pb.RegisterAdminServiceServer(grpcServer, &AdminEsServer{})
+	// 健康检查服务
+	healthpb.RegisterHealthServer(grpcServer, health.Server)
 	// 启用反射服务（用于调试）
 	reflection.Register(grpcServer)
 }
+
+// ServiceNames 需要维护健康状态的gRPC服务
+func ServiceNames() []string {
+	return []string{
+		pb.ProductsSearchService_ServiceDesc.ServiceName,
+		pb.PriceSearchService_ServiceDesc.ServiceName,
+		pb.BomService_ServiceDesc.ServiceName,
+		pb.AdminService_ServiceDesc.ServiceName,
+	}
+}
@@ -0,0 +1,24 @@
+package router
+
+import (
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// GracefulStop 停止接收新连接并等待进行中的请求完成, 超过 timeout 后强制关闭连接
+// 服务由 NewServer 创建(WaitForHandlers), 强制关闭时仍等待服务实现返回
+func GracefulStop(s *grpc.Server, timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		s.Stop()
+		<-done
+	}
+}
@@ -0,0 +1,191 @@
+package validate
+
+import (
+	"easyms-es/easyes"
+	ms "easyms-es/protos/messages"
+)
+
+// 校验上限, 服务层已有截断的参数(如搜索 Size 大于100时按100处理)只校验不会产生ES错误的范围
+const (
+	maxResultWindow = 10000 // Elasticsearch 默认的 index.max_result_window
+	maxTextLength   = 200   // 关键词、型号、品牌等文本
+	maxIDs          = 1000  // ID过滤条件的数量
+	maxAttributes   = 50
+	maxCustomFields = 20
+	maxTokenLength  = 1024 // 导出续传令牌、异步搜索ID
+	maxCurrencies   = 20
+	currencyLength  = 3 // ISO 4217
+	maxExportChunk  = 2000
+	maxFacetSize    = 200
+	maxNameIndex    = 37
+	maxPriceTier    = 5
+	maxCollapsePIDs = 200
+	maxCollapseTop  = 20
+	maxBomRows      = 500
+	maxTopOffers    = 20
+	maxWriteSize    = 1000
+	maxStepPrices   = 20
+)
+
+// pageRules 分页参数, from+size 不能超过ES的结果窗口
+func pageRules(from string, size string) []Rule {
+	return []Rule{
+		Field(from, Range(0, maxResultWindow)),
+		Field(size, Range(0, maxResultWindow)),
+		Window(from, size, maxResultWindow),
+	}
+}
+
+// idsRule ID过滤条件, 每个ID为正数
+func idsRule(name string) Rule {
+	return Field(name, MaxItems(maxIDs), Each(Min(1)))
+}
+
+// refreshRule 实时写入的刷新策略, 为空时使用默认策略
+func refreshRule() Rule {
+	return Field("Refresh", OneOf("", easyes.RefreshFalse, easyes.RefreshTrue, easyes.RefreshWaitFor))
+}
+
+func init() {
+	// 产品搜索
+	Register(&ms.ProductSearchParam{}, append([]Rule{
+		Field("KeyWord", MaxLen(maxTextLength)),
+		Field("ProductName", MaxLen(maxTextLength)),
+		Field("Brand", MaxLen(maxTextLength)),
+		Field("Category", MaxLen(maxTextLength)),
+		Field("PassiveParam", MaxLen(maxTextLength)),
+		idsRule("ParentIDs"),
+		idsRule("CategoryIDs"),
+		idsRule("BrandIDs"),
+		idsRule("DistributorIDs"),
+		Field("Attributes", MaxItems(maxAttributes), Nested()),
+	}, pageRules("From", "Size")...)...)
+	Register(&ms.Attribute{},
+		Field("AttributeName", Required(), MaxLen(maxTextLength)),
+		Field("AttributeValues", MaxItems(maxIDs), Each(MaxLen(maxTextLength))),
+	)
+	Register(&ms.FacetSearchParam{},
+		Field("Query", Nested()),
+		Field("Size", Range(0, maxFacetSize)),
+	)
+	Register(&ms.ProductIndexSearchParam{},
+		Field("ProductNameIndex", Range(0, maxNameIndex)),
+		Field("ParentID", Min(0)),
+		Field("CategoryID", Min(0)),
+		Field("Size", Range(0, maxResultWindow)),
+		Field("LastPID", Min(0)),
+	)
+	Register(&ms.CustomSearchProductParam{}, append([]Rule{
+		Field("KeyWords", MaxItems(maxCustomFields)),
+		Field("Filters", MaxItems(maxCustomFields)),
+		Field("TermsFilters", MaxItems(maxCustomFields)),
+		Field("Range", Nested()),
+		Field("AttributeNames", MaxItems(maxAttributes), Each(MaxLen(maxTextLength))),
+		Field("Sources", MaxLen(maxTokenLength)),
+		Field("Sorts", MaxItems(maxCustomFields)),
+		Field("Boots", MaxItems(maxCustomFields), Each(Min(0))),
+	}, pageRules("From", "Size")...)...)
+	Register(&ms.CustomRange{},
+		Field("Field", MaxLen(maxTextLength)),
+	)
+	Register(&ms.ExportProductsParam{},
+		Field("BrandID", Min(0)),
+		Field("ParentID", Min(0)),
+		Field("CategoryID", Min(0)),
+		Field("DistributorID", Min(0)),
+		Field("ChunkSize", Range(0, maxExportChunk)),
+		Field("ResumeToken", MaxLen(maxTokenLength)),
+	)
+	Register(&ms.SubmitSearchParam{},
+		Field("Query", Nested()),
+		Field("FacetSize", Range(0, maxFacetSize)),
+	)
+	Register(&ms.AsyncSearchID{},
+		Field("ID", Required(), MaxLen(maxTokenLength)),
+	)
+
+	// 价格搜索
+	Register(&ms.PriceSearchParam{}, append([]Rule{
+		Field("PID", Min(1)),
+		Field("DistributorType", Range(0, 2)),
+		idsRule("DistributorIDs"),
+		Field("MinStock", Min(0)),
+		Field("SortType", Enum()),
+		Field("PriceTier", Range(0, maxPriceTier)),
+		Field("Currencies", MaxItems(maxCurrencies), Each(MaxLen(currencyLength))),
+		Field("TargetCurrency", MaxLen(currencyLength)),
+	}, pageRules("From", "Size")...)...)
+	Register(&ms.ProductsPriceSearchParam{},
+		Field("PIDs", Required(), MaxItems(maxCollapsePIDs), Each(Min(1))),
+		Field("TopSize", Range(0, maxCollapseTop)),
+	)
+	Register(&ms.QuoteParam{},
+		Field("PID", Min(1)),
+		Field("Quantity", Min(1)),
+		Field("DistributorType", Range(0, 2)),
+		idsRule("DistributorIDs"),
+		Field("Currencies", MaxItems(maxCurrencies), Each(MaxLen(currencyLength))),
+		Field("TargetCurrency", MaxLen(currencyLength)),
+	)
+	Register(&ms.ExportPricesParam{},
+		Field("BrandID", Min(0)),
+		Field("DistributorID", Min(0)),
+		Field("ChunkSize", Range(0, maxExportChunk)),
+		Field("ResumeToken", MaxLen(maxTokenLength)),
+	)
+
+	// BOM匹配, 空型号的行在结果中标记错误, 不拒绝整个请求
+	Register(&ms.MatchBOMParam{},
+		Field("Rows", MaxItems(maxBomRows), Nested()),
+		Field("TopOffers", Range(0, maxTopOffers)),
+		Field("TargetCurrency", MaxLen(currencyLength)),
+	)
+	Register(&ms.BomRow{},
+		Field("PartNumber", MaxLen(maxTextLength)),
+		Field("Manufacturer", MaxLen(maxTextLength)),
+		Field("Quantity", Min(0)),
+	)
+
+	// 实时写入
+	Register(&ms.UpsertProductsParam{},
+		Field("Products", Required(), MaxItems(maxWriteSize), Nested()),
+		refreshRule(),
+	)
+	Register(&ms.ProductDoc{},
+		Field("PID", Min(1)),
+		Field("ProductName", Required(), MaxLen(maxTextLength)),
+		Field("Brand", MaxLen(maxTextLength)),
+		Field("BrandID", Min(0)),
+		Field("CategoryID", Min(0)),
+		Field("ParentID", Min(0)),
+	)
+	Register(&ms.DeleteProductsParam{},
+		Field("PIDs", Required(), MaxItems(maxWriteSize), Each(Min(1))),
+		refreshRule(),
+	)
+	Register(&ms.UpsertPricesParam{},
+		Field("Prices", Required(), MaxItems(maxWriteSize), Nested()),
+		refreshRule(),
+	)
+	Register(&ms.PriceDoc{},
+		Field("SID", Min(0)),
+		Field("PID", Min(1)),
+		Field("DistributorType", Min(0)),
+		Field("DistributorID", Min(0)),
+		Field("ProductName", MaxLen(maxTextLength)),
+		Field("Brand", MaxLen(maxTextLength)),
+		Field("StockNum", Min(0)),
+		Field("Currency", MaxLen(currencyLength)),
+		Field("StepPrices", MaxItems(maxStepPrices), Nested()),
+		Field("MOQ", Min(0)),
+		Field("Multiples", Min(0)),
+	)
+	Register(&ms.StepPrice{},
+		Field("Qty", Min(1)),
+		Field("Price", Min(0)),
+	)
+	Register(&ms.DeletePricesParam{},
+		Field("SPIDs", Required(), MaxItems(maxWriteSize), Each(Required(), MaxLen(maxTextLength))),
+		refreshRule(),
+	)
+}
@@ -0,0 +1,245 @@
+// Package validate 请求参数的声明式校验, 按消息类型登记字段规则, 由拦截器在调用服务实现前执行
+// 校验失败返回 errno.InvalidParam, 详情为 errdetails.BadRequest, 列出全部不合规的字段
+package validate
+
+import (
+	"easyms-es/errno"
+	"fmt"
+	"reflect"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Violation 字段违规项
+type Violation = errdetails.BadRequest_FieldViolation
+
+// Check 字段值的校验, path 为字段路径, 如 Rows[2].PartNumber
+type Check func(fd protoreflect.FieldDescriptor, v protoreflect.Value, path string) []*Violation
+
+// Rule 消息的校验规则, fields 为涉及的字段, 登记时检查字段是否存在
+type Rule struct {
+	fields []string
+	check  func(msg protoreflect.Message, path string) []*Violation
+}
+
+// registry 消息类型到规则的映射, 只在 init 中登记, 之后只读
+var registry = make(map[protoreflect.FullName][]Rule)
+
+// Register 登记消息的校验规则, 字段不存在时 panic, 避免规则因字段改名静默失效
+func Register(m proto.Message, rules ...Rule) {
+	desc := m.ProtoReflect().Descriptor()
+	for _, rule := range rules {
+		for _, name := range rule.fields {
+			if desc.Fields().ByName(protoreflect.Name(name)) == nil {
+				panic(fmt.Sprintf("validate: %s has no field %s", desc.FullName(), name))
+			}
+		}
+	}
+	registry[desc.FullName()] = append(registry[desc.FullName()], rules...)
+}
+
+// Message 按登记的规则校验请求, 未登记的消息类型不校验
+func Message(m proto.Message) error {
+	violations := messageViolations(m.ProtoReflect(), "")
+	if len(violations) == 0 {
+		return nil
+	}
+
+	msg := fmt.Sprintf("%s %s", violations[0].Field, violations[0].Description)
+	if len(violations) > 1 {
+		msg += fmt.Sprintf(" (and %d more)", len(violations)-1)
+	}
+	return errno.InvalidParam("%s", msg).WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+}
+
+// messageViolations 执行消息类型的全部规则
+func messageViolations(msg protoreflect.Message, path string) []*Violation {
+	var violations []*Violation
+	for _, rule := range registry[msg.Descriptor().FullName()] {
+		violations = append(violations, rule.check(msg, path)...)
+	}
+	return violations
+}
+
+// Field 字段规则, 依次执行各项校验
+func Field(name string, checks ...Check) Rule {
+	return Rule{
+		fields: []string{name},
+		check: func(msg protoreflect.Message, path string) []*Violation {
+			fd := msg.Descriptor().Fields().ByName(protoreflect.Name(name))
+			fieldPath := joinPath(path, name)
+			var violations []*Violation
+			for _, check := range checks {
+				violations = append(violations, check(fd, msg.Get(fd), fieldPath)...)
+			}
+			return violations
+		},
+	}
+}
+
+// Window 分页窗口, from+size 不能超过 max, 对应 Elasticsearch 的 index.max_result_window
+func Window(from string, size string, max int64) Rule {
+	return Rule{
+		fields: []string{from, size},
+		check: func(msg protoreflect.Message, path string) []*Violation {
+			fields := msg.Descriptor().Fields()
+			fromValue := msg.Get(fields.ByName(protoreflect.Name(from))).Int()
+			sizeValue := msg.Get(fields.ByName(protoreflect.Name(size))).Int()
+			if fromValue+sizeValue > max {
+				return violation(joinPath(path, from), fmt.Sprintf("%s+%s must be less than or equal to %d", from, size, max))
+			}
+			return nil
+		},
+	}
+}
+
+// Required 必填: 字符串、列表及映射不能为空, 数值不能为0, 消息必须设置, 可用于 Each 校验列表元素
+func Required() Check {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, path string) []*Violation {
+		empty := false
+		switch value := v.Interface().(type) {
+		case protoreflect.List:
+			empty = value.Len() == 0
+		case protoreflect.Map:
+			empty = value.Len() == 0
+		case protoreflect.Message:
+			empty = !value.IsValid()
+		default:
+			empty = reflect.ValueOf(value).IsZero()
+		}
+		if empty {
+			return violation(path, "is required")
+		}
+		return nil
+	}
+}
+
+// Min 数值不小于 min
+func Min(min float64) Check {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, path string) []*Violation {
+		if number(fd, v) < min {
+			return violation(path, fmt.Sprintf("must be greater than or equal to %v", min))
+		}
+		return nil
+	}
+}
+
+// Range 数值在 [min, max] 之间
+func Range(min float64, max float64) Check {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, path string) []*Violation {
+		if n := number(fd, v); n < min || n > max {
+			return violation(path, fmt.Sprintf("must be between %v and %v", min, max))
+		}
+		return nil
+	}
+}
+
+// MaxLen 字符串长度(字符数)不超过 max
+func MaxLen(max int) Check {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, path string) []*Violation {
+		if utf8.RuneCountInString(v.String()) > max {
+			return violation(path, fmt.Sprintf("length must be less than or equal to %d", max))
+		}
+		return nil
+	}
+}
+
+// MaxItems 列表或映射的元素数不超过 max
+func MaxItems(max int) Check {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, path string) []*Violation {
+		n := 0
+		if fd.IsMap() {
+			n = v.Map().Len()
+		} else {
+			n = v.List().Len()
+		}
+		if n > max {
+			return violation(path, fmt.Sprintf("must contain at most %d items", max))
+		}
+		return nil
+	}
+}
+
+// Enum 枚举值必须已定义
+func Enum() Check {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, path string) []*Violation {
+		if fd.Enum().Values().ByNumber(v.Enum()) == nil {
+			return violation(path, fmt.Sprintf("must be a defined %s value", fd.Enum().Name()))
+		}
+		return nil
+	}
+}
+
+// OneOf 字符串必须为给定值之一
+func OneOf(values ...string) Check {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, path string) []*Violation {
+		for _, value := range values {
+			if v.String() == value {
+				return nil
+			}
+		}
+		return violation(path, fmt.Sprintf("must be one of %q", values))
+	}
+}
+
+// Each 对列表的每个元素执行校验, 路径为 Field[i]
+func Each(checks ...Check) Check {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, path string) []*Violation {
+		var violations []*Violation
+		list := v.List()
+		for i := 0; i < list.Len(); i++ {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			for _, check := range checks {
+				violations = append(violations, check(fd, list.Get(i), itemPath)...)
+			}
+		}
+		return violations
+	}
+}
+
+// Nested 按嵌套消息类型登记的规则校验, 列表时校验每个元素, 未设置时跳过
+func Nested() Check {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, path string) []*Violation {
+		if fd.IsList() {
+			var violations []*Violation
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				violations = append(violations, messageViolations(list.Get(i).Message(), fmt.Sprintf("%s[%d]", path, i))...)
+			}
+			return violations
+		}
+		if !v.Message().IsValid() {
+			return nil
+		}
+		return messageViolations(v.Message(), path)
+	}
+}
+
+// number 数值字段的值
+func number(fd protoreflect.FieldDescriptor, v protoreflect.Value) float64 {
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return float64(v.Int())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return float64(v.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return v.Float()
+	default:
+		panic(fmt.Sprintf("validate: %s is not a number", fd.FullName()))
+	}
+}
+
+func violation(path string, description string) []*Violation {
+	return []*Violation{{Field: path, Description: description}}
+}
+
+func joinPath(path string, name string) string {
+	if len(path) == 0 {
+		return name
+	}
+	return path + "." + name
+}
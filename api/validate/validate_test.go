@@ -0,0 +1,187 @@
+package validate
+
+import (
+	ms "easyms-es/protos/messages"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMessage(t *testing.T) {
+	filters := make(map[string]string)
+	for i := 0; i <= maxCustomFields; i++ {
+		filters[fmt.Sprintf("Field%d", i)] = "1"
+	}
+
+	tests := []struct {
+		name string
+		msg  proto.Message
+		want []string // 违规字段路径, 按规则顺序
+	}{
+		{
+			name: "valid product search",
+			msg:  &ms.ProductSearchParam{KeyWord: "stm32", ParentIDs: []int32{1}, Attributes: []*ms.Attribute{{AttributeName: "封装"}}, From: 20, Size: 20},
+		},
+		{
+			name: "window at max",
+			msg:  &ms.ProductSearchParam{From: 9990, Size: 10},
+		},
+		{
+			name: "window exceeded",
+			msg:  &ms.ProductSearchParam{From: 9995, Size: 10},
+			want: []string{"From"},
+		},
+		{
+			name: "negative from",
+			msg:  &ms.ProductSearchParam{From: -1},
+			want: []string{"From"},
+		},
+		{
+			name: "size above window",
+			msg:  &ms.ProductSearchParam{Size: maxResultWindow + 1},
+			want: []string{"Size", "From"},
+		},
+		{
+			name: "text length counts characters",
+			msg:  &ms.ProductSearchParam{KeyWord: strings.Repeat("电", maxTextLength)},
+		},
+		{
+			name: "text too long",
+			msg:  &ms.ProductSearchParam{KeyWord: strings.Repeat("电", maxTextLength+1)},
+			want: []string{"KeyWord"},
+		},
+		{
+			name: "each id positive",
+			msg:  &ms.ProductSearchParam{ParentIDs: []int32{1, 0, -2}},
+			want: []string{"ParentIDs[1]", "ParentIDs[2]"},
+		},
+		{
+			name: "nested list item",
+			msg:  &ms.ProductSearchParam{Attributes: []*ms.Attribute{{AttributeName: "封装"}, {}}},
+			want: []string{"Attributes[1].AttributeName"},
+		},
+		{
+			name: "bom rows",
+			msg:  &ms.MatchBOMParam{Rows: []*ms.BomRow{{PartNumber: ""}, {PartNumber: "stm32", Quantity: -1}}, TopOffers: maxTopOffers + 1},
+			want: []string{"Rows[1].Quantity", "TopOffers"},
+		},
+		{
+			name: "undefined enum",
+			msg:  &ms.PriceSearchParam{PID: 1, SortType: ms.PriceSortType(99)},
+			want: []string{"SortType"},
+		},
+		{
+			name: "currency length",
+			msg:  &ms.PriceSearchParam{PID: 1, Currencies: []string{"USD", "USDT"}, TargetCurrency: "CNYX"},
+			want: []string{"Currencies[1]", "TargetCurrency"},
+		},
+		{
+			name: "required list and one of",
+			msg:  &ms.UpsertProductsParam{Refresh: "now"},
+			want: []string{"Products", "Refresh"},
+		},
+		{
+			name: "nested required and min",
+			msg:  &ms.UpsertProductsParam{Products: []*ms.ProductDoc{{PID: 1, ProductName: "stm32"}, {}}, Refresh: "wait_for"},
+			want: []string{"Products[1].PID", "Products[1].ProductName"},
+		},
+		{
+			name: "each required",
+			msg:  &ms.DeletePricesParam{SPIDs: []string{"1-2-3", ""}},
+			want: []string{"SPIDs[1]"},
+		},
+		{
+			name: "unset nested message",
+			msg:  &ms.CustomSearchProductParam{},
+		},
+		{
+			name: "nested message",
+			msg:  &ms.CustomSearchProductParam{Range: &ms.CustomRange{Field: strings.Repeat("a", maxTextLength+1)}},
+			want: []string{"Range.Field"},
+		},
+		{
+			name: "map items",
+			msg:  &ms.CustomSearchProductParam{Filters: filters},
+			want: []string{"Filters"},
+		},
+		{
+			name: "unregistered message",
+			msg:  &ms.KeyWordList{Values: []string{strings.Repeat("a", maxTextLength+1)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Message(tt.msg)
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatalf("Message() error = %v", err)
+				}
+				return
+			}
+
+			st, ok := status.FromError(err)
+			if !ok || st.Code() != codes.InvalidArgument {
+				t.Fatalf("Message() error = %v, want InvalidArgument", err)
+			}
+			var got []string
+			for _, detail := range st.Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					for _, v := range badRequest.FieldViolations {
+						got = append(got, v.Field)
+					}
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("Message() violations = %v, want %v", got, tt.want)
+			}
+			if !strings.HasPrefix(st.Message(), tt.want[0]+" ") {
+				t.Fatalf("Message() message = %q, want prefix %q", st.Message(), tt.want[0])
+			}
+			if len(tt.want) > 1 && !strings.HasSuffix(st.Message(), fmt.Sprintf("(and %d more)", len(tt.want)-1)) {
+				t.Fatalf("Message() message = %q, want count of other violations", st.Message())
+			}
+		})
+	}
+}
+
+func TestWindow(t *testing.T) {
+	rule := Window("From", "Size", 100)
+	tests := []struct {
+		from, size int32
+		want       bool
+	}{
+		{from: 0, size: 100},
+		{from: 90, size: 10},
+		{from: 91, size: 10, want: true},
+		{from: 100, size: 1, want: true},
+		{from: 0, size: 0},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d+%d", tt.from, tt.size), func(t *testing.T) {
+			msg := &ms.ProductSearchParam{From: tt.from, Size: tt.size}
+			violations := rule.check(msg.ProtoReflect(), "Query")
+			if got := len(violations) > 0; got != tt.want {
+				t.Fatalf("Window() violations = %v, want violation %v", violations, tt.want)
+			}
+			if tt.want && violations[0].Field != "Query.From" {
+				t.Fatalf("Window() field = %s, want Query.From", violations[0].Field)
+			}
+		})
+	}
+}
+
+func TestRegisterUnknownField(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Register() with an unknown field did not panic")
+		}
+	}()
+	Register(&ms.KeyWordList{}, Field("Value", MaxItems(1)))
+}
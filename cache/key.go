@@ -54,3 +54,8 @@ func GetBrandCategoryAggKey(brandId int, prentCategoryId int) string {
 func GetBrandCategoryAggParentCategoryKey(brandId int) string {
 	return fmt.Sprintf("brandaggs-%d:pcategoryagg", brandId)
 }
+
+// GetAsyncSearchOwnerKey 异步搜索任务所属客户端
+func GetAsyncSearchOwnerKey(id string) string {
+	return fmt.Sprintf("asyncsearch-%s", id)
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"os"
 	"time"
 
 	"google.golang.org/grpc"
@@ -16,9 +17,10 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
+// 客户端授权码从环境变量读取, 由 clientctl 生成
 var (
-	validClientID     = "easy"
-	validClientSecret = "obsaekcHSan38yNVEKMJd6LfoMyv2KWG"
+	validClientID     = os.Getenv("EASY_CLIENT_ID")
+	validClientSecret = os.Getenv("EASY_CLIENT_SECRET")
 )
 
 func EasyGrpcClient() error {
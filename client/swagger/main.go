@@ -4,59 +4,19 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"easyms-es/api/gateway"
 	"easyms-es/config"
-	pb "easyms-es/protos/services"
 	"io/ioutil"
 	"log"
 
 	"github.com/gin-gonic/gin"
-	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
-	swaggerFiles "github.com/swaggo/files"
-	ginSwagger "github.com/swaggo/gin-swagger"
-	"github.com/swaggo/swag"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/metadata"
-)
-
-const docTemplate = `{
-    "schemes": {{ marshal .Schemes }},
-    "swagger": "2.0",
-    "info": {
-        "description": "{{escape .Description}}",
-        "title": "{{.Title}}",
-        "contact": {},
-        "version": "{{.Version}}"
-    },
-    "host": "{{.Host}}",
-    "basePath": "{{.BasePath}}",
-    "paths": {}
-}`
-
-// SwaggerInfo holds exported Swagger Info so clients can modify it
-var SwaggerInfo = &swag.Spec{
-	Version:          "0.9.0",
-	Host:             "",
-	BasePath:         "",
-	Schemes:          []string{},
-	Title:            "",
-	Description:      "",
-	InfoInstanceName: "swagger",
-	SwaggerTemplate:  docTemplate,
-	LeftDelim:        "{{",
-	RightDelim:       "}}",
-}
-
-var (
-	validClientID     = "easy"
-	validClientSecret = "obsaekcHSan38yNVEKMJd6LfoMyv2KWG"
 )
 
 func init() {
 	//初始化配置文件数组
 	config.InitConfig("job")
-
-	swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
 }
 
 func main() {
@@ -69,7 +29,6 @@ func main() {
 
 	g := gin.Default()
 
-	mux := runtime.NewServeMux()
 	// 加载 gRPC 服务的 TLS 凭证
 	// 加载客户端证书
 	certificate, err := tls.LoadX509KeyPair("./certs/client.crt", "./certs/client.key")
@@ -96,52 +55,18 @@ func main() {
 
 	cred := credentials.NewTLS(tlsConfig)
 
-	unaryInterceptor := func(
-		ctx context.Context,
-		method string,
-		req, reply interface{},
-		cc *grpc.ClientConn,
-		invoker grpc.UnaryInvoker,
-		opts ...grpc.CallOption,
-	) error {
-		// 创建上下文，并设置元数据（Client-ID 和 Client-Secret）
-		md := metadata.New(map[string]string{
-			"Client-ID":     validClientID,
-			"Client-Secret": validClientSecret,
-		})
-		// 将 metadata 附加到 context
-		ctx = metadata.NewOutgoingContext(ctx, md)
-		// 继续调用 gRPC 方法
-		return invoker(ctx, method, req, reply, cc, opts...)
-	}
-
-	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(cred),
-		grpc.WithUnaryInterceptor(unaryInterceptor)}
-
-	// 创建上下文，并设置元数据（Client-ID 和 Client-Secret）
-	ctx := context.Background()
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
-	err = pb.RegisterProductsSearchServiceHandlerFromEndpoint(ctx, mux, "grpc.easy.dev:50052", opts)
+	// 授权请求头(Client-ID, Client-Secret)由网关转发, 不在此处写死
+	conn, err := grpc.NewClient("grpc.easy.dev:50052", grpc.WithTransportCredentials(cred))
 	if err != nil {
-		log.Fatalf("failed to register gateway: %v", err)
+		log.Fatalf("failed to dial grpc: %v", err)
 	}
+	defer conn.Close()
 
-	err = pb.RegisterPriceSearchServiceHandlerFromEndpoint(ctx, mux, "grpc.easy.dev:50052", opts)
+	handler, err := gateway.NewHandler(context.Background(), conn)
 	if err != nil {
 		log.Fatalf("failed to register gateway: %v", err)
 	}
-
-	g.GET("/swagger-doc/swagger.json", func(c *gin.Context) {
-		c.File("./protos/services/search.swagger.json")
-	})
-
-	url := ginSwagger.URL("/swagger-doc/swagger.json")
-	g.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, url))
-
-	g.Any("/v1/*any", gin.WrapH(mux))
+	g.Any("/*any", gin.WrapH(handler))
 
 	isTls, exit := config.GetAppConfigValue[bool]("common.server.tls")
 	if exit == false {
@@ -91,6 +91,54 @@ func GetAppConfigValue[T any](key string) (*T, bool) {
 	return getConfigValue[T](v, key)
 }
 
+// GetAppConfigIntSlice 获取系统配置(整型数组), yaml 中的数组无法直接断言为[]int
+func GetAppConfigIntSlice(key string) ([]int, bool) {
+	v := GetAppConfig()
+	if v == nil {
+		return nil, false
+	}
+	v.Mutex.Lock()
+	defer v.Mutex.Unlock()
+
+	if v.ConfigFile.IsSet(key) == false {
+		return nil, false
+	}
+
+	return v.ConfigFile.GetIntSlice(key), true
+}
+
+// GetAppConfigStringMapSlice 获取系统配置(字符串数组的字典), 如 scope 对应的方法列表
+func GetAppConfigStringMapSlice(key string) (map[string][]string, bool) {
+	v := GetAppConfig()
+	if v == nil {
+		return nil, false
+	}
+	v.Mutex.Lock()
+	defer v.Mutex.Unlock()
+
+	if v.ConfigFile.IsSet(key) == false {
+		return nil, false
+	}
+
+	return v.ConfigFile.GetStringMapStringSlice(key), true
+}
+
+// UnmarshalAppConfigKey 将系统配置解析到结构体, 用于规则列表等复杂配置
+func UnmarshalAppConfigKey(key string, rawVal any) (bool, error) {
+	v := GetAppConfig()
+	if v == nil {
+		return false, nil
+	}
+	v.Mutex.Lock()
+	defer v.Mutex.Unlock()
+
+	if v.ConfigFile.IsSet(key) == false {
+		return false, nil
+	}
+
+	return true, v.ConfigFile.UnmarshalKey(key, rawVal)
+}
+
 // 获取配置值
 func getConfigValue[T any](v *ViperConfig, key string) (*T, bool) {
 	if v == nil {
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"easyms-es/config"
 	"easyms-es/crob_job/jobs/productjob"
 	"easyms-es/crob_job/jobs/rediscachejob"
@@ -9,20 +10,28 @@ import (
 	easylib "easyms-es/crob_job/lib"
 	"easyms-es/db"
 	"easyms-es/easyes"
+	"easyms-es/metrics"
 	"easyms-es/model"
 	"easyms-es/service/prices"
 	"easyms-es/service/products"
+	"easyms-es/tracing"
+	"errors"
 	"fmt"
 	"github.com/gin-contrib/static"
 	"github.com/gin-gonic/gin"
 	"log"
 	"net/http"
+	"os/signal"
 	"strconv"
+	"syscall"
 	"time"
 )
 
 var AppName = "job"
 
+// shutdownTracing 停机时导出剩余的span
+var shutdownTracing = func(context.Context) error { return nil }
+
 // 初始化项目
 func init() {
 	// 日志
@@ -33,6 +42,20 @@ func init() {
 	//初始化配置文件数组
 	config.InitConfig(AppName)
 
+	// 链路追踪, 每次任务运行为一个trace
+	var tracingConfig tracing.Config
+	if _, err := config.UnmarshalAppConfigKey("common.tracing", &tracingConfig); err != nil {
+		log.Fatal(err)
+	}
+	if len(tracingConfig.ServiceName) == 0 {
+		tracingConfig.ServiceName = "easyes-" + AppName
+	}
+	shutdown, err := tracing.Init(context.Background(), tracingConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+	shutdownTracing = shutdown
+
 	//初始化dbConfig
 	config.CreateDBConfig(AppName)
 
@@ -67,7 +90,9 @@ func init() {
 	prices.PriceStore = *store2
 
 	//redis
-	db.InitRedis()
+	if err := db.InitRedis(); err != nil {
+		log.Fatal(err)
+	}
 }
 
 // InitEasyJobManager 通过配置文件初始化
@@ -106,7 +131,9 @@ func main() {
 
 	easylib.EasyJobManager.Start()
 
-	defer easylib.EasyJobManager.Stop()
+	// 停机信号, 收到后停止接收管理请求并等待任务处理完当前页
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
 	r := gin.Default()
 
@@ -121,6 +148,9 @@ func main() {
 
 	r.Use(static.Serve("/", static.LocalFile(*webui, false)))
 
+	// Prometheus 指标
+	r.GET("/metrics", gin.WrapH(metrics.Handler()))
+
 	// 获取任务列表
 	r.GET("/jobs", func(c *gin.Context) {
 		jobs := easylib.EasyJobManager.ListJobs()
@@ -173,5 +203,32 @@ func main() {
 		c.JSON(http.StatusOK, gin.H{"message": message})
 	})
 
-	_ = r.Run(fmt.Sprintf(":%d", 8087))
+	server := &http.Server{Addr: fmt.Sprintf(":%d", 8087), Handler: r}
+	go func() {
+		if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("failed to serve: %v", err)
+			stop()
+		}
+	}()
+
+	<-ctx.Done()
+	log.Println("shutting down, waiting for running jobs")
+
+	shutdownTimeout := 5 * time.Minute
+	if v, ok := config.GetAppConfigValue[int]("common.shutdowntimeout"); ok && *v > 0 {
+		shutdownTimeout = time.Duration(*v) * time.Second
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("failed to shutdown server: %v", err)
+	}
+	if err := easylib.EasyJobManager.Shutdown(shutdownCtx); err != nil {
+		log.Printf("jobs not finished before shutdown: %v", err)
+	}
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.Printf("failed to shutdown tracing: %v", err)
+	}
+	log.Println("job stopped")
 }
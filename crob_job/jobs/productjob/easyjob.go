@@ -2,6 +2,7 @@
 package productjob
 
 import (
+	"context"
 	easylib "easyms-es/crob_job/lib"
 	"easyms-es/model"
 	"easyms-es/service/products"
@@ -23,7 +24,7 @@ func (job *EasyJob[T]) GetDataPageList() ([]interface{}, []interface{}, interfac
 		job.Description += "jobConfig.LastId >= maxPid \n"
 		return nil, nil, maxPid, nil
 	}
-	ps, delProducts, lastId, err := QueryProduct(jobConfig.Lastid, jobConfig.Limit)
+	ps, delProducts, lastId, err := QueryProduct(job.Context(), jobConfig.Lastid, jobConfig.Limit)
 	if err != nil {
 		return nil, nil, jobConfig.Lastid, err
 	}
@@ -43,14 +44,14 @@ func (job *EasyJob[T]) RemoveEs(data []interface{}) error {
 
 func (job *EasyJob[T]) UpdateEs(data []interface{}) error {
 	ps := easylib.MapperToProducts(data)
-	err := products.BulkInsert(ps)
+	err := products.BulkInsert(job.Context(), ps)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func QueryProduct(lastId int, limit int) ([]model.Product, []model.Product, int, error) {
+func QueryProduct(ctx context.Context, lastId int, limit int) ([]model.Product, []model.Product, int, error) {
 	param := fmt.Sprintf("PID > %d ", lastId)
-	return easylib.QueryProduct(param, limit)
+	return easylib.QueryProduct(ctx, param, limit)
 }
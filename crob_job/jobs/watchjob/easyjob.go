@@ -50,6 +50,9 @@ func (job *EasyJob) SaveSyncStatusConfig(status int) error {
 }
 
 func (job *EasyJob) Run() {
+	ctx, span := easylib.StartRunSpan(job.JobName)
+	defer span.End()
+
 	err := job.GetSyncConfig()
 	if err != nil {
 		job.DoError(err, "GetSyncConfig error:")
@@ -87,7 +90,7 @@ func (job *EasyJob) Run() {
 		PID int
 	}
 	var lastUser Product
-	db.TenantPoolInstance.GetTable("Products").Last(&lastUser)
+	db.TenantPoolInstance.GetTable("Products").WithContext(ctx).Last(&lastUser)
 	maxPid = lastUser.PID
 
 	if job.JobConfig.Maxpid < maxPid {
@@ -1,11 +1,17 @@
 package lib
 
 import (
+	"context"
 	"easyms-es/config"
 	"easyms-es/model"
 	"fmt"
 	"log"
 	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // BaseJob Job基类
@@ -15,12 +21,31 @@ type BaseJob[T model.JobConfig] struct {
 	Description string
 	RetryCount  int
 	EasyFunc    interface{}
+	ctx         context.Context
+}
+
+// StartRunSpan 开始一次任务运行的根span, 运行中的SQL查询及es写入为其子span
+func StartRunSpan(jobName string) (context.Context, trace.Span) {
+	return otel.Tracer("easyms-es/job").Start(context.Background(), "job "+jobName,
+		trace.WithAttributes(attribute.String("job.name", jobName)),
+	)
+}
+
+// Context 本次运行的上下文, 查询及写入时传入以关联到任务的span
+func (job *BaseJob[T]) Context() context.Context {
+	if job.ctx == nil {
+		return context.Background()
+	}
+	return job.ctx
 }
 
 // DoError 任务过程中储物处理（Job状态更新，新增错误日志）
 func (job *BaseJob[T]) DoError(err error, description string) {
 	job.RetryCount++
 	log.Println(description, err)
+	span := trace.SpanFromContext(job.Context())
+	span.RecordError(err)
+	span.SetStatus(codes.Error, description)
 	EasyJobManager.UpdateEasyJobInfo(&EasyJobParam{
 		JobName:     job.JobName,
 		Status:      -1,
@@ -88,6 +113,9 @@ func (job *BaseJob[T]) RemoveEs([]interface{}) error {
 // Run Job 运行方法
 func (job *BaseJob[T]) Run() {
 	start := time.Now()
+	ctx, span := StartRunSpan(job.JobName)
+	defer span.End()
+	job.ctx = ctx
 
 	if job.RetryCount >= 5 {
 		EasyJobManager.UpdateEasyJobInfo(&EasyJobParam{
@@ -175,6 +203,8 @@ func (job *BaseJob[T]) Run() {
 		Status:      1,
 		Description: job.Description,
 		Interval:    time.Since(start),
+		Items:       len(data),
 	})
+	span.SetAttributes(attribute.Int("job.items", len(data)))
 	job.Description = ""
 }
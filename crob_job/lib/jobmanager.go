@@ -1,7 +1,9 @@
 package lib
 
 import (
+	"context"
 	"easyms-es/config"
+	"easyms-es/metrics"
 	"errors"
 	"fmt"
 	"log"
@@ -36,6 +38,7 @@ type EasyJobParam struct {
 	Status      int
 	Description string
 	RetryCount  int
+	Items       int // 本次运行写入索引的文档数
 }
 
 type EasyJobResponseData struct {
@@ -71,6 +74,16 @@ func (jm *JobManager) Stop() {
 	jm.cron.Stop()
 }
 
+// Shutdown 停止调度新的任务, 等待正在执行的任务处理完当前页, ctx 超时返回错误
+func (jm *JobManager) Shutdown(ctx context.Context) error {
+	select {
+	case <-jm.cron.Stop().Done():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // AddJob 添加Job
 func (jm *JobManager) AddJob(jobName string, jobFunc func()) error {
 	jm.mutex.Lock()
@@ -264,6 +277,8 @@ func (jm *JobManager) UpdateEasyJobInfo(jobParam *EasyJobParam) {
 	if !exists {
 		return
 	}
+	observeJob(job, jobParam)
+
 	if !jobParam.LastRun.IsZero() {
 		job.LastRun = jobParam.LastRun
 	}
@@ -280,3 +295,23 @@ func (jm *JobManager) UpdateEasyJobInfo(jobParam *EasyJobParam) {
 		job.RetryCount = jobParam.RetryCount
 	}
 }
+
+// observeJob 在更新任务状态前记录任务指标, 运行结束时带有耗时(Interval), 出错时状态为 -1, 重试过多停止时为 -2
+// 运行中(状态2)出错时以本次开始时间计算失败耗时
+func observeJob(job *EasyJob, jobParam *EasyJobParam) {
+	switch {
+	case jobParam.Status >= 0 && jobParam.Interval > 0:
+		metrics.JobDuration.WithLabelValues(job.JobName, "success").Observe(jobParam.Interval.Seconds())
+		metrics.JobLastSuccess.WithLabelValues(job.JobName).SetToCurrentTime()
+		if jobParam.Items > 0 {
+			metrics.JobItemsIndexed.WithLabelValues(job.JobName).Add(float64(jobParam.Items))
+		}
+	case jobParam.Status == -1:
+		metrics.JobFailures.WithLabelValues(job.JobName).Inc()
+		if job.Status == 2 && !job.LastRun.IsZero() {
+			metrics.JobDuration.WithLabelValues(job.JobName, "failure").Observe(time.Since(job.LastRun).Seconds())
+		}
+	case jobParam.Status == -2:
+		metrics.JobFailures.WithLabelValues(job.JobName).Inc()
+	}
+}
@@ -4,32 +4,47 @@ import (
 	"context"
 	"easyms-es/config"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 )
 
 var EasyRedis *redis.Client
 
-// InitRedis 初始化redis client
-func InitRedis() {
+// InitRedis 初始化redis client, 连接成功后才赋值 EasyRedis
+// 密码优先取环境变量 EASY_REDIS_PASSWORD, 其次取配置 common.redis.password, 均未设置时不使用密码
+func InitRedis() error {
 	address, exit := config.GetAppConfigValue[string]("common.redis.address")
 	if !exit {
-		panic("redis address not found")
+		return errors.New("redis address not found")
 	}
-	password, exit := config.GetAppConfigValue[string]("common.redis.password")
-	if !exit {
-		panic("redis password not found")
+	password := os.Getenv("EASY_REDIS_PASSWORD")
+	if len(password) == 0 {
+		if value, exit := config.GetAppConfigValue[string]("common.redis.password"); exit {
+			password = *value
+		}
 	}
-	dbNum, exit := config.GetAppConfigValue[int]("common.redis.db")
-	if !exit {
-		panic("redis db not found")
+	dbNum := 0
+	if value, exit := config.GetAppConfigValue[int]("common.redis.db"); exit {
+		dbNum = *value
 	}
 
-	EasyRedis = redis.NewClient(&redis.Options{
+	client := redis.NewClient(&redis.Options{
 		Addr:     *address,
-		Password: *password, // no password set
-		DB:       *dbNum,    // use default DB
+		Password: password,
+		DB:       dbNum,
 	})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return fmt.Errorf("failed to connect redis %s: %v", *address, err)
+	}
+	EasyRedis = client
+	return nil
 }
 
 // ExistsKey 判断key是否存在
@@ -49,6 +64,16 @@ func SetCache(key string, value interface{}) error {
 	return EasyRedis.Set(ctx, key, val, 0).Err()
 }
 
+// SetExpireCache 设置带有效期的缓存值
+func SetExpireCache(key string, value interface{}, expiration time.Duration) error {
+	ctx := context.Background()
+	val, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return EasyRedis.Set(ctx, key, val, expiration).Err()
+}
+
 // SetHashCache 设置hash值
 func SetHashCache(key string, values map[string]interface{}) error {
 	ctx := context.Background()
@@ -121,3 +146,11 @@ func GetHashSetCache(key string, field string) ([]string, error) {
 	}
 	return vals, nil
 }
+
+// PingRedis 检查redis连接, 未初始化时返回错误
+func PingRedis(ctx context.Context) error {
+	if EasyRedis == nil {
+		return errors.New("redis is not initialized")
+	}
+	return EasyRedis.Ping(ctx).Err()
+}
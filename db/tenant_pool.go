@@ -2,6 +2,7 @@ package db
 
 import (
 	"easyms-es/config"
+	"easyms-es/tracing"
 	"gorm.io/driver/sqlserver"
 	"gorm.io/gorm"
 	"sync"
@@ -30,6 +31,10 @@ func NewTenantPool() *TenantPool {
 		if err != nil {
 			panic("failed to connect database")
 		}
+		// 查询span, 通过 GetTable(...).WithContext(ctx) 关联到调用方
+		if err := db.Use(tracing.GormPlugin{}); err != nil {
+			panic("failed to register tracing plugin")
+		}
 		pool.pools[tenant.Id] = db
 	}
 	return pool
@@ -0,0 +1,123 @@
+package easyes
+
+import (
+	"bytes"
+	"context"
+	"easyms-es/errno"
+	"easyms-es/utility"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// 批量写入后的刷新策略
+const (
+	RefreshFalse   = "false"    // 不刷新, 由索引的 refresh_interval 决定可见时间
+	RefreshTrue    = "true"     // 立即刷新相关分片
+	RefreshWaitFor = "wait_for" // 等待下一次刷新后返回
+)
+
+// BulkItemResult 批量操作的单条结果
+type BulkItemResult struct {
+	ID     string `json:"id"`
+	Result string `json:"result,omitempty"` // created/updated/deleted/not_found
+	Status int    `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// IsRefreshPolicy 是否为有效的刷新策略
+func IsRefreshPolicy(refresh string) bool {
+	return refresh == RefreshFalse || refresh == RefreshTrue || refresh == RefreshWaitFor
+}
+
+// BulkWithResult 批量写入并返回每条数据的结果, opt 为 index/update/delete, 删除时 items 为文档ID
+func (s *Store) BulkWithResult(ctx context.Context, items []any, opt string, refresh string) (_ []BulkItemResult, err error) {
+	if len(items) < 1 {
+		return nil, nil
+	}
+	if !IsRefreshPolicy(refresh) {
+		return nil, errno.InvalidParam("refresh policy is error: %s", refresh)
+	}
+
+	var buf bytes.Buffer
+	for _, item := range items {
+		documentId, ok := item.(string)
+		if !ok {
+			documentId = utility.GetFieldIDTag(item)
+		}
+		if documentId == "" {
+			return nil, fmt.Errorf("bulk obj id is error")
+		}
+
+		meta, _ := json.Marshal(map[string]any{opt: map[string]string{"_id": documentId}})
+		buf.Write(meta)
+		buf.WriteString("\n")
+		if opt == "delete" {
+			continue
+		}
+
+		var data []byte
+		if opt == "update" {
+			data, _ = json.Marshal(map[string]any{"doc": item})
+		} else {
+			data, _ = json.Marshal(item)
+		}
+		buf.Write(data)
+		buf.WriteString("\n")
+	}
+
+	ctx, span := s.startSpan(ctx, "bulk", buf.Len())
+	defer func() { endSpan(span, err) }()
+	span.SetAttributes(attribute.Int("db.elasticsearch.items", len(items)))
+
+	res, err := s.es.Bulk(
+		bytes.NewReader(buf.Bytes()),
+		s.es.Bulk.WithContext(ctx),
+		s.es.Bulk.WithIndex(s.IndexName),
+		s.es.Bulk.WithRefresh(refresh),
+	)
+	if err != nil {
+		return nil, errno.Upstream("bulk", err)
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			log.Printf("failed to close body: %v", err)
+		}
+	}(res.Body)
+
+	if res.IsError() {
+		return nil, errno.UpstreamStatus("bulk", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, res.String()))
+	}
+
+	// 每条结果以操作类型为键, 如 {"index":{...}}
+	var r struct {
+		Items []map[string]struct {
+			ID     string `json:"_id"`
+			Result string `json:"result"`
+			Status int    `json:"status"`
+			Error  struct {
+				Type   string `json:"type"`
+				Reason string `json:"reason"`
+			} `json:"error"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+		return nil, fmt.Errorf("bulk decode: %w", err)
+	}
+
+	results := make([]BulkItemResult, 0, len(r.Items))
+	for _, item := range r.Items {
+		for _, d := range item {
+			result := BulkItemResult{ID: d.ID, Result: d.Result, Status: d.Status}
+			if len(d.Error.Type) > 0 {
+				result.Error = fmt.Sprintf("%s: %s", d.Error.Type, d.Error.Reason)
+			}
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
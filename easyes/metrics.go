@@ -0,0 +1,46 @@
+package easyes
+
+import (
+	"easyms-es/metrics"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// observeRequest 记录Elasticsearch请求耗时, 由 fasthttp 传输层在请求完成后回调
+func observeRequest(req *http.Request, statusCode int, err error, latency time.Duration) {
+	operation, index := parseRequestPath(req.Method, req.URL.Path)
+	status := strconv.Itoa(statusCode)
+	if err != nil {
+		status = "error"
+	}
+	metrics.EsLatency.WithLabelValues(operation, index, status).Observe(latency.Seconds())
+}
+
+// parseRequestPath 从请求路径解析操作及索引, 如 /product_easy/_search 为 search、product_easy
+// 不带索引的请求(如 /_search 的PIT搜索、/_async_search/{id})索引为 -, 只有索引的请求操作为 index_{method}
+func parseRequestPath(method string, path string) (string, string) {
+	index := "-"
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "_") {
+			operation := segment[1:]
+			// _cluster/health/{index} 等集群接口取两级, 索引在最后
+			if operation == "cluster" && i+1 < len(segments) {
+				operation += "_" + segments[i+1]
+				if i+2 < len(segments) {
+					index = segments[i+2]
+				}
+			}
+			if operation == "doc" {
+				operation += "_" + strings.ToLower(method)
+			}
+			return operation, index
+		}
+		if i == 0 && len(segment) > 0 {
+			index = segment
+		}
+	}
+	return "index_" + strings.ToLower(method), index
+}
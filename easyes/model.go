@@ -18,8 +18,31 @@ type SearchResponse struct {
 		Hits []struct {
 			Source    json.RawMessage     `json:"_source"`
 			Highlight map[string][]string `json:"highlight"`
+			Sort      []any               `json:"sort,omitempty"`
 		}
 	}
+	PitID        string                 `json:"pit_id,omitempty"`
+	Aggregations map[string]Aggregation `json:"aggregations,omitempty"`
+	Suggest      map[string][]Suggest   `json:"suggest,omitempty"`
+}
+
+// Suggest 建议器结果, 每个分词一项
+type Suggest struct {
+	Text    string `json:"text"`
+	Options []struct {
+		Text  string  `json:"text"`
+		Score float64 `json:"score"`
+	} `json:"options"`
+}
+
+// AsyncSearchResponse 异步搜索结果, Response 在任务运行中时为部分结果
+type AsyncSearchResponse struct {
+	ID                     string         `json:"id"`
+	IsRunning              bool           `json:"is_running"`
+	IsPartial              bool           `json:"is_partial"`
+	StartTimeInMillis      int64          `json:"start_time_in_millis"`
+	ExpirationTimeInMillis int64          `json:"expiration_time_in_millis"`
+	Response               SearchResponse `json:"response"`
 }
 
 type CollapseSearchResponse struct {
@@ -72,6 +95,7 @@ type Aggregation struct {
 				} `json:"hits,omitempty"`
 			} `json:"hits,omitempty"`
 		} `json:"top,omitempty"`
+		ChildAgg *Aggregation `json:"child_agg,omitempty"`
 	} `json:"buckets,omitempty"`
 }
 
@@ -0,0 +1,150 @@
+package easyes
+
+import (
+	"context"
+	"easyms-es/errno"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+)
+
+// OpenPointInTime 打开时间点(PIT), 用于深度分页的一致性快照
+func (s *Store) OpenPointInTime(ctx context.Context, keepAlive string) (_ string, err error) {
+	ctx, span := s.startSpan(ctx, "open_point_in_time", 0)
+	defer func() { endSpan(span, err) }()
+	res, err := s.es.OpenPointInTime(
+		[]string{s.IndexName},
+		keepAlive,
+		s.es.OpenPointInTime.WithContext(ctx),
+	)
+	if err != nil {
+		return "", errno.Upstream("open_point_in_time", err)
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			log.Printf("failed to close body: %v", err)
+		}
+	}(res.Body)
+
+	if res.IsError() {
+		return "", errno.UpstreamStatus("open_point_in_time", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, res.String()))
+	}
+
+	var r struct {
+		ID string `json:"id"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+		return "", err
+	}
+	return r.ID, nil
+}
+
+// ClosePointInTime 关闭时间点(PIT), 释放快照资源
+func (s *Store) ClosePointInTime(ctx context.Context, pitID string) (err error) {
+	body, err := json.Marshal(map[string]string{"id": pitID})
+	if err != nil {
+		return err
+	}
+	ctx, span := s.startSpan(ctx, "close_point_in_time", len(body))
+	defer func() { endSpan(span, err) }()
+
+	res, err := s.es.ClosePointInTime(
+		s.es.ClosePointInTime.WithContext(ctx),
+		s.es.ClosePointInTime.WithBody(strings.NewReader(string(body))),
+	)
+	if err != nil {
+		return errno.Upstream("close_point_in_time", err)
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			log.Printf("failed to close body: %v", err)
+		}
+	}(res.Body)
+
+	if res.IsError() {
+		return errno.UpstreamStatus("close_point_in_time", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, res.String()))
+	}
+	return nil
+}
+
+// SearchPit 基于PIT的搜索, 请求体中包含pit, 不能指定索引
+func (s *Store) SearchPit(ctx context.Context, body string) (_ *SearchResponse, err error) {
+	ctx, span := s.startSpan(ctx, "search", len(body))
+	defer func() { endSpan(span, err) }()
+	res, err := s.es.Search(
+		s.es.Search.WithContext(ctx),
+		s.es.Search.WithBody(strings.NewReader(body)),
+		s.es.Search.WithTrackTotalHits(true),
+	)
+	if err != nil {
+		return nil, errno.Upstream("search", err)
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			log.Printf("failed to close body: %v", err)
+		}
+	}(res.Body)
+
+	if res.IsError() {
+		return nil, errno.UpstreamStatus("search", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, body))
+	}
+
+	var r SearchResponse
+	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+		return nil, err
+	}
+	setSearchResult(span, r.Took, r.Hits.Total.Value)
+
+	return &r, nil
+}
+
+// WalkPointInTime 通过 PIT + search_after 遍历查询的全部结果, 每页调用一次 fn
+// query 需包含确定性的 sort 及 size, fn 返回错误时终止遍历, 结束后关闭PIT, 调用方取消时也会关闭
+func (s *Store) WalkPointInTime(ctx context.Context, query map[string]any, keepAlive string, fn func(res *SearchResponse) error) error {
+	pitID, err := s.OpenPointInTime(ctx, keepAlive)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := s.ClosePointInTime(context.WithoutCancel(ctx), pitID); err != nil {
+			log.Printf("failed to close pit: %v", err)
+		}
+	}()
+
+	var searchAfter []any
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		query["pit"] = map[string]any{"id": pitID, "keep_alive": keepAlive}
+		if len(searchAfter) > 0 {
+			query["search_after"] = searchAfter
+		}
+		body, err := json.Marshal(query)
+		if err != nil {
+			return err
+		}
+
+		res, err := s.SearchPit(ctx, string(body))
+		if err != nil {
+			return err
+		}
+		if len(res.Hits.Hits) == 0 {
+			return nil
+		}
+		if len(res.PitID) > 0 {
+			pitID = res.PitID
+		}
+
+		if err := fn(res); err != nil {
+			return err
+		}
+		searchAfter = res.Hits.Hits[len(res.Hits.Hits)-1].Sort
+	}
+}
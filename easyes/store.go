@@ -6,6 +6,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"easyms-es/config"
+	"easyms-es/errno"
 	"easyms-es/fasthttp"
 	"easyms-es/model"
 	"easyms-es/utility"
@@ -20,6 +21,7 @@ import (
 
 	"github.com/elastic/go-elasticsearch/v8"
 	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // StoreConfig Store存储配置参数
@@ -95,15 +97,19 @@ func NewStore(c StoreConfig) (*Store, error) {
 	caCertPool := x509.NewCertPool()
 	caCertPool.AppendCertsFromPEM(cert)
 
+	transport := fasthttp.NewTransport(&tls.Config{
+		RootCAs:    caCertPool,
+		MinVersion: tls.VersionTLS12,
+		MaxVersion: tls.VersionTLS13,
+	})
+	// 按操作及索引记录请求耗时
+	transport.Observe = observeRequest
+
 	cfg := elasticsearch.Config{
 		Addresses: strings.Split(*esAddress, ","),
 		Username:  *esUserName,
 		Password:  *esPassword,
-		Transport: fasthttp.NewTransport(&tls.Config{
-			RootCAs:    caCertPool,
-			MinVersion: tls.VersionTLS12,
-			MaxVersion: tls.VersionTLS13,
-		}),
+		Transport: transport,
 	}
 
 	es, err := elasticsearch.NewClient(cfg)
@@ -117,10 +123,16 @@ func NewStore(c StoreConfig) (*Store, error) {
 }
 
 // CreateIndex 通过mapping创建索引
-func (s *Store) CreateIndex(mapping string) error {
-	res, err := s.es.Indices.Create(s.IndexName, s.es.Indices.Create.WithBody(strings.NewReader(mapping)))
+func (s *Store) CreateIndex(ctx context.Context, mapping string) (err error) {
+	ctx, span := s.startSpan(ctx, "create_index", len(mapping))
+	defer func() { endSpan(span, err) }()
+	res, err := s.es.Indices.Create(
+		s.IndexName,
+		s.es.Indices.Create.WithContext(ctx),
+		s.es.Indices.Create.WithBody(strings.NewReader(mapping)),
+	)
 	if err != nil {
-		return fmt.Errorf("es error creating index: %s", err.Error())
+		return errno.Upstream("create_index", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -129,13 +141,13 @@ func (s *Store) CreateIndex(mapping string) error {
 		}
 	}(res.Body)
 	if res.IsError() {
-		return fmt.Errorf("es error: [%s] [%s] %s", res.Status(), s.IndexName, mapping)
+		return errno.UpstreamStatus("create_index", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, mapping))
 	}
 	return nil
 }
 
 // Create 插入一条索引数据
-func (s *Store) Create(item interface{}) error {
+func (s *Store) Create(ctx context.Context, item interface{}) (err error) {
 	payload, err := json.Marshal(item)
 	if err != nil {
 		return err
@@ -147,14 +159,15 @@ func (s *Store) Create(item interface{}) error {
 	}
 	documentId := _id
 
-	ctx := context.Background()
+	ctx, span := s.startSpan(ctx, "create", len(payload))
+	defer func() { endSpan(span, err) }()
 	res, err := esapi.CreateRequest{
 		Index:      s.IndexName,
 		DocumentID: documentId,
 		Body:       bytes.NewReader(payload),
 	}.Do(ctx, s.es)
 	if err != nil {
-		return fmt.Errorf("es error insert doc request: %s", err.Error())
+		return errno.Upstream("create", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -164,14 +177,14 @@ func (s *Store) Create(item interface{}) error {
 	}(res.Body)
 
 	if res.IsError() {
-		return fmt.Errorf("es error [%s] [%s] %s", res.Status(), s.IndexName, res.Body)
+		return errno.UpstreamStatus("create", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, res.Body))
 	}
 
 	return nil
 }
 
 // Bulk 批量插入,更新,删除   对象要进行处理_id赋值,注意struct 中对tag id
-func (s *Store) Bulk(items interface{}, flag ...string) error {
+func (s *Store) Bulk(ctx context.Context, items interface{}, flag ...string) (err error) {
 	opt := "index"
 	if len(flag) > 0 {
 		opt = flag[0]
@@ -212,10 +225,18 @@ func (s *Store) Bulk(items interface{}, flag ...string) error {
 		}
 	}
 
-	res, err := s.es.Bulk(bytes.NewReader(buf.Bytes()), s.es.Bulk.WithIndex(s.IndexName))
+	ctx, span := s.startSpan(ctx, "bulk", buf.Len())
+	defer func() { endSpan(span, err) }()
+	span.SetAttributes(attribute.Int("db.elasticsearch.items", len(array)))
+
+	res, err := s.es.Bulk(
+		bytes.NewReader(buf.Bytes()),
+		s.es.Bulk.WithContext(ctx),
+		s.es.Bulk.WithIndex(s.IndexName),
+	)
 
 	if err != nil {
-		return fmt.Errorf("es error bulk insert docs request: %s", err.Error())
+		return errno.Upstream("bulk", err)
 	}
 
 	defer func(Body io.ReadCloser) {
@@ -250,9 +271,9 @@ func (s *Store) Bulk(items interface{}, flag ...string) error {
 		var raw map[string]interface{}
 		if err := json.NewDecoder(res.Body).Decode(&raw); err != nil {
 			//log.Fatalf("Failure to to parse response body: %s", err)
-			return fmt.Errorf("Failure to to parse response body: %s", err.Error())
+			return errno.UpstreamStatus("bulk", res.StatusCode, fmt.Sprintf("failure to parse response body: %s", err.Error()))
 		}
-		return fmt.Errorf("[%s] %s: %s", res.Status(), raw["error"].(map[string]interface{})["type"], raw["error"].(map[string]interface{})["reason"])
+		return errno.UpstreamStatus("bulk", res.StatusCode, fmt.Sprintf("%s: %s", raw["error"].(map[string]interface{})["type"], raw["error"].(map[string]interface{})["reason"]))
 	} else {
 		if err := json.NewDecoder(res.Body).Decode(&blk); err != nil {
 			//log.Fatalf("Failure to to parse response body: %s", err)
@@ -281,14 +302,16 @@ func (s *Store) Bulk(items interface{}, flag ...string) error {
 }
 
 // Get 通过DocumentID获取一条数据
-func (s *Store) Get(id string) (*DocResponse, error) {
+func (s *Store) Get(ctx context.Context, id string) (_ *DocResponse, err error) {
+	ctx, span := s.startSpan(ctx, "get", 0)
+	defer func() { endSpan(span, err) }()
 	req := esapi.GetRequest{
 		Index:      s.IndexName,
 		DocumentID: id,
 	}
-	res, err := req.Do(context.Background(), s.es)
+	res, err := req.Do(ctx, s.es)
 	if err != nil {
-		return nil, fmt.Errorf("es error getting doc: %s", err.Error())
+		return nil, errno.Upstream("get", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -296,8 +319,11 @@ func (s *Store) Get(id string) (*DocResponse, error) {
 			log.Printf("failed to close body: %v", err)
 		}
 	}(res.Body)
+	if res.StatusCode == 404 {
+		return nil, errno.NotFound("document %s not found", id)
+	}
 	if res.IsError() {
-		return nil, fmt.Errorf("es error [%s] [%s] %s ID NOT EXIST.", res.Status(), s.IndexName, id)
+		return nil, errno.UpstreamStatus("get", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, id))
 	}
 	var r DocResponse
 	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
@@ -308,10 +334,12 @@ func (s *Store) Get(id string) (*DocResponse, error) {
 }
 
 // Exists 查询DocumentID是否存在
-func (s *Store) Exists(id string) (bool, error) {
-	res, err := s.es.Exists(s.IndexName, id)
+func (s *Store) Exists(ctx context.Context, id string) (_ bool, err error) {
+	ctx, span := s.startSpan(ctx, "exists", 0)
+	defer func() { endSpan(span, err) }()
+	res, err := s.es.Exists(s.IndexName, id, s.es.Exists.WithContext(ctx))
 	if err != nil {
-		return false, fmt.Errorf("es error Exists doc: %s", err.Error())
+		return false, errno.Upstream("exists", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -325,19 +353,21 @@ func (s *Store) Exists(id string) (bool, error) {
 	case 404:
 		return false, nil
 	default:
-		return false, fmt.Errorf("es error Exists error [%s] [%s] %s", res.Status(), s.IndexName, id)
+		return false, errno.UpstreamStatus("exists", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, id))
 	}
 }
 
 // Count 获取查询条件的数量
-func (s *Store) Count(body string) (int, error) {
+func (s *Store) Count(ctx context.Context, body string) (_ int, err error) {
+	ctx, span := s.startSpan(ctx, "count", len(body))
+	defer func() { endSpan(span, err) }()
 	res, err := s.es.Count(
-		s.es.Count.WithContext(context.Background()),
+		s.es.Count.WithContext(ctx),
 		s.es.Count.WithIndex(s.IndexName),
 		s.es.Count.WithBody(strings.NewReader(body)),
 	)
 	if err != nil {
-		return 0, fmt.Errorf("es error Count: %s", err.Error())
+		return 0, errno.Upstream("count", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -347,7 +377,7 @@ func (s *Store) Count(body string) (int, error) {
 	}(res.Body)
 
 	if res.IsError() {
-		return 0, fmt.Errorf("es error [%s] [%s] %s", res.Status(), s.IndexName, body)
+		return 0, errno.UpstreamStatus("count", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, body))
 	}
 
 	type CountResponse struct {
@@ -358,20 +388,23 @@ func (s *Store) Count(body string) (int, error) {
 	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
 		return 0, err
 	}
+	span.SetAttributes(attribute.Int("db.elasticsearch.hits", r.Count))
 
 	return r.Count, nil
 }
 
 // Search 搜索
-func (s *Store) Search(body string) (*SearchResponse, error) {
+func (s *Store) Search(ctx context.Context, body string) (_ *SearchResponse, err error) {
+	ctx, span := s.startSpan(ctx, "search", len(body))
+	defer func() { endSpan(span, err) }()
 	res, err := s.es.Search(
-		s.es.Search.WithContext(context.Background()),
+		s.es.Search.WithContext(ctx),
 		s.es.Search.WithIndex(s.IndexName),
 		s.es.Search.WithBody(strings.NewReader(body)),
 		s.es.Search.WithTrackTotalHits(true),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("es error Search: %s", err.Error())
+		return nil, errno.Upstream("search", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -381,26 +414,84 @@ func (s *Store) Search(body string) (*SearchResponse, error) {
 	}(res.Body)
 
 	if res.IsError() {
-		return nil, fmt.Errorf("es error [%s] [%s] %s", res.Status(), s.IndexName, body)
+		return nil, errno.UpstreamStatus("search", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, body))
 	}
 
 	var r SearchResponse
 	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
 		return nil, err
 	}
+	setSearchResult(span, r.Took, r.Hits.Total.Value)
 
 	return &r, nil
 }
 
+// MultiSearch 多个搜索合并为一次msearch请求, 结果与 bodies 顺序一致, 任一搜索失败时返回错误
+func (s *Store) MultiSearch(ctx context.Context, bodies []string) (_ []SearchResponse, err error) {
+	header, err := json.Marshal(map[string]string{"index": s.IndexName})
+	if err != nil {
+		return nil, err
+	}
+	var buf strings.Builder
+	for _, body := range bodies {
+		buf.Write(header)
+		buf.WriteString("\n")
+		buf.WriteString(body)
+		buf.WriteString("\n")
+	}
+
+	ctx, span := s.startSpan(ctx, "msearch", buf.Len())
+	defer func() { endSpan(span, err) }()
+	res, err := s.es.Msearch(
+		strings.NewReader(buf.String()),
+		s.es.Msearch.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, errno.Upstream("msearch", err)
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			log.Printf("failed to close body: %v", err)
+		}
+	}(res.Body)
+
+	if res.IsError() {
+		return nil, errno.UpstreamStatus("msearch", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, buf.String()))
+	}
+
+	var r struct {
+		Responses []struct {
+			SearchResponse
+			Status int             `json:"status"`
+			Error  json.RawMessage `json:"error"`
+		} `json:"responses"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+		return nil, err
+	}
+
+	responses := make([]SearchResponse, 0, len(r.Responses))
+	for _, item := range r.Responses {
+		if len(item.Error) > 0 {
+			return nil, errno.UpstreamStatus("msearch", item.Status, fmt.Sprintf("[%s] %s", s.IndexName, item.Error))
+		}
+		responses = append(responses, item.SearchResponse)
+	}
+	return responses, nil
+}
+
 // SearchGradeAgg 多级分组聚合
-func (s *Store) SearchGradeAgg(body string) (*GradeAggResult, error) {
+func (s *Store) SearchGradeAgg(ctx context.Context, body string) (_ *GradeAggResult, err error) {
+	ctx, span := s.startSpan(ctx, "search", len(body))
+	defer func() { endSpan(span, err) }()
 	res, err := s.es.Search(
-		s.es.Search.WithContext(context.Background()),
+		s.es.Search.WithContext(ctx),
 		s.es.Search.WithIndex(s.IndexName),
 		s.es.Search.WithBody(strings.NewReader(body)),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("es error SearchGradeAgg: %s", err.Error())
+		return nil, errno.Upstream("search", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -410,7 +501,7 @@ func (s *Store) SearchGradeAgg(body string) (*GradeAggResult, error) {
 	}(res.Body)
 
 	if res.IsError() {
-		return nil, fmt.Errorf("es error [%s] [%s] %s", res.Status(), s.IndexName, body)
+		return nil, errno.UpstreamStatus("search", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, body))
 	}
 
 	var r GradeAggResult
@@ -422,14 +513,16 @@ func (s *Store) SearchGradeAgg(body string) (*GradeAggResult, error) {
 }
 
 // SearchAgg 搜索普通聚合
-func (s *Store) SearchAgg(body string) (*AggResult, error) {
+func (s *Store) SearchAgg(ctx context.Context, body string) (_ *AggResult, err error) {
+	ctx, span := s.startSpan(ctx, "search", len(body))
+	defer func() { endSpan(span, err) }()
 	res, err := s.es.Search(
-		s.es.Search.WithContext(context.Background()),
+		s.es.Search.WithContext(ctx),
 		s.es.Search.WithIndex(s.IndexName),
 		s.es.Search.WithBody(strings.NewReader(body)),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("es error SearchAgg: %s", err.Error())
+		return nil, errno.Upstream("search", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -439,7 +532,7 @@ func (s *Store) SearchAgg(body string) (*AggResult, error) {
 	}(res.Body)
 
 	if res.IsError() {
-		return nil, fmt.Errorf("es error [%s] [%s] %s", res.Status(), s.IndexName, body)
+		return nil, errno.UpstreamStatus("search", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, body))
 	}
 
 	var r AggResult
@@ -451,15 +544,17 @@ func (s *Store) SearchAgg(body string) (*AggResult, error) {
 }
 
 // SearchAggMultiple 并发聚合（多个聚合同时搜索）
-func (s *Store) SearchAggMultiple(body string) (*[]AggResult, error) {
+func (s *Store) SearchAggMultiple(ctx context.Context, body string) (_ *[]AggResult, err error) {
+	ctx, span := s.startSpan(ctx, "msearch", len(body))
+	defer func() { endSpan(span, err) }()
 	req := esapi.MsearchRequest{
 		Body:   strings.NewReader(body),
 		Pretty: true,
 	}
 
-	res, err := req.Do(context.Background(), s.es)
+	res, err := req.Do(ctx, s.es)
 	if err != nil {
-		return nil, fmt.Errorf("es error SearchAggMultiple: %s", err.Error())
+		return nil, errno.Upstream("msearch", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -469,7 +564,7 @@ func (s *Store) SearchAggMultiple(body string) (*[]AggResult, error) {
 	}(res.Body)
 
 	if res.IsError() {
-		return nil, fmt.Errorf("es error [%s] [%s] %s", res.Status(), s.IndexName, body)
+		return nil, errno.UpstreamStatus("msearch", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, body))
 	}
 
 	type MResponses struct {
@@ -485,15 +580,17 @@ func (s *Store) SearchAggMultiple(body string) (*[]AggResult, error) {
 }
 
 // SearchSamplerAggMultiple 采样聚合(多)
-func (s *Store) SearchSamplerAggMultiple(body string, aggNames []string) (*[]AggResult, error) {
+func (s *Store) SearchSamplerAggMultiple(ctx context.Context, body string, aggNames []string) (_ *[]AggResult, err error) {
+	ctx, span := s.startSpan(ctx, "msearch", len(body))
+	defer func() { endSpan(span, err) }()
 	req := esapi.MsearchRequest{
 		Body:   strings.NewReader(body),
 		Pretty: true,
 	}
 
-	res, err := req.Do(context.Background(), s.es)
+	res, err := req.Do(ctx, s.es)
 	if err != nil {
-		return nil, fmt.Errorf("es error SearchSamplerAggMultiple: %s", err.Error())
+		return nil, errno.Upstream("msearch", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -503,7 +600,7 @@ func (s *Store) SearchSamplerAggMultiple(body string, aggNames []string) (*[]Agg
 	}(res.Body)
 
 	if res.IsError() {
-		return nil, fmt.Errorf("es error [%s] [%s] %s", res.Status(), s.IndexName, body)
+		return nil, errno.UpstreamStatus("msearch", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, body))
 	}
 
 	type MResponses struct {
@@ -539,14 +636,16 @@ func (s *Store) SearchSamplerAggMultiple(body string, aggNames []string) (*[]Agg
 }
 
 // SearchCollapse 折叠去重
-func (s *Store) SearchCollapse(body string) (*CollapseSearchResponse, error) {
+func (s *Store) SearchCollapse(ctx context.Context, body string) (_ *CollapseSearchResponse, err error) {
+	ctx, span := s.startSpan(ctx, "search", len(body))
+	defer func() { endSpan(span, err) }()
 	res, err := s.es.Search(
-		s.es.Search.WithContext(context.Background()),
+		s.es.Search.WithContext(ctx),
 		s.es.Search.WithIndex(s.IndexName),
 		s.es.Search.WithBody(strings.NewReader(body)),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("es error SearchCollapse: %s", err.Error())
+		return nil, errno.Upstream("search", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -556,31 +655,34 @@ func (s *Store) SearchCollapse(body string) (*CollapseSearchResponse, error) {
 	}(res.Body)
 
 	if res.IsError() {
-		return nil, fmt.Errorf("es error [%s] [%s] %s", res.Status(), s.IndexName, body)
+		return nil, errno.UpstreamStatus("search", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, body))
 	}
 
 	var r CollapseSearchResponse
 	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
 		return nil, err
 	}
+	setSearchResult(span, r.Took, r.Hits.Total.Value)
 
 	return &r, nil
 }
 
 // Analyze 分析
-func (s *Store) Analyze(analyzer string, text string) (*Tokens, error) {
+func (s *Store) Analyze(ctx context.Context, analyzer string, text string) (_ *Tokens, err error) {
 	dsl := fmt.Sprintf(`{
 		"analyzer" : "%s",
 		"text": "%s"
 		}`, analyzer, text)
+	ctx, span := s.startSpan(ctx, "analyze", len(dsl))
+	defer func() { endSpan(span, err) }()
 
 	ares, err := s.es.Indices.Analyze(
-		s.es.Indices.Analyze.WithContext(context.Background()),
+		s.es.Indices.Analyze.WithContext(ctx),
 		s.es.Indices.Analyze.WithIndex(s.IndexName),
 		s.es.Indices.Analyze.WithBody(strings.NewReader(dsl)),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("es error Analyze request: %s", err.Error())
+		return nil, errno.Upstream("analyze", err)
 	}
 
 	defer func(Body io.ReadCloser) {
@@ -591,7 +693,7 @@ func (s *Store) Analyze(analyzer string, text string) (*Tokens, error) {
 	}(ares.Body)
 
 	if ares.IsError() {
-		return nil, fmt.Errorf("es error [%s] [%s] %s", ares.Status(), s.IndexName, dsl)
+		return nil, errno.UpstreamStatus("analyze", ares.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, dsl))
 	}
 
 	var r Tokens
@@ -603,17 +705,19 @@ func (s *Store) Analyze(analyzer string, text string) (*Tokens, error) {
 }
 
 // UpdateByQuery query to update document  批量按照条件更新document
-func (s *Store) UpdateByQuery(body string) (string, error) {
+func (s *Store) UpdateByQuery(ctx context.Context, body string) (_ string, err error) {
+	ctx, span := s.startSpan(ctx, "update_by_query", len(body))
+	defer func() { endSpan(span, err) }()
 	res, err := s.es.UpdateByQuery(
 		[]string{s.IndexName},
-		s.es.UpdateByQuery.WithContext(context.Background()),
+		s.es.UpdateByQuery.WithContext(ctx),
 		s.es.UpdateByQuery.WithBody(strings.NewReader(body)),
 		s.es.UpdateByQuery.WithConflicts("proceed"),
 		s.es.UpdateByQuery.WithWaitForCompletion(false),
 		s.es.UpdateByQuery.WithPretty(),
 	)
 	if err != nil {
-		return "", fmt.Errorf("es error UpdateByQuery: %s", err.Error())
+		return "", errno.Upstream("update_by_query", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -665,18 +769,24 @@ func (s *Store) MonitorTask(taskID string) (bool, error) {
 	}
 }
 
+// AsyncSearchKeepAlive 异步搜索任务及结果的有效时长
+const AsyncSearchKeepAlive = 10 * time.Minute
+
 // AsyncSearch 异步搜索,针对超长时间查询
-func (s *Store) AsyncSearch(body string) (string, error) {
+func (s *Store) AsyncSearch(ctx context.Context, body string) (_ string, err error) {
+	ctx, span := s.startSpan(ctx, "async_search_submit", len(body))
+	defer func() { endSpan(span, err) }()
 	res, err := s.es.AsyncSearch.Submit(
-		s.es.AsyncSearch.Submit.WithContext(context.Background()),
+		s.es.AsyncSearch.Submit.WithContext(ctx),
 		s.es.AsyncSearch.Submit.WithIndex(s.IndexName),
 		s.es.AsyncSearch.Submit.WithBody(strings.NewReader(body)),
 		s.es.AsyncSearch.Submit.WithKeepOnCompletion(true),                        // 保持搜索结果
 		s.es.AsyncSearch.Submit.WithWaitForCompletionTimeout(50*time.Millisecond), // 初始等待时间
-		s.es.AsyncSearch.Submit.WithKeepAlive(10*time.Minute),                     // 任务有效时长
+		s.es.AsyncSearch.Submit.WithKeepAlive(AsyncSearchKeepAlive),               // 任务有效时长
+		s.es.AsyncSearch.Submit.WithTrackTotalHits(true),
 	)
 	if err != nil {
-		return "", fmt.Errorf("es error AsyncSearch: %s", err.Error())
+		return "", errno.Upstream("async_search_submit", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -686,7 +796,7 @@ func (s *Store) AsyncSearch(body string) (string, error) {
 	}(res.Body)
 
 	if res.IsError() {
-		return "", fmt.Errorf("es error : [%s] [%s] %s", res.Status(), s.IndexName, res.String())
+		return "", errno.UpstreamStatus("async_search_submit", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, res.String()))
 	}
 
 	// 提取异步搜索任务 ID
@@ -697,15 +807,17 @@ func (s *Store) AsyncSearch(body string) (string, error) {
 	return responseMap["id"].(string), nil
 }
 
-// GetAsyncSearchResult 获取异步搜索结果
-func (s *Store) GetAsyncSearchResult(taskID string) (*esapi.Response, error) {
+// GetAsyncSearchResult 获取异步搜索结果, 在关闭响应体前完成解码
+func (s *Store) GetAsyncSearchResult(ctx context.Context, taskID string) (_ *AsyncSearchResponse, err error) {
+	ctx, span := s.startSpan(ctx, "async_search_get", 0)
+	defer func() { endSpan(span, err) }()
 	res, err := s.es.AsyncSearch.Get(
 		taskID,
-		s.es.AsyncSearch.Get.WithContext(context.Background()),
+		s.es.AsyncSearch.Get.WithContext(ctx),
 		s.es.AsyncSearch.Get.WithWaitForCompletionTimeout(1*time.Second), // 等待一段时间以查看任务是否完成
 	)
 	if err != nil {
-		return nil, fmt.Errorf("es error GetAsyncSearchResult: %s", err.Error())
+		return nil, errno.Upstream("async_search_get", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -714,22 +826,32 @@ func (s *Store) GetAsyncSearchResult(taskID string) (*esapi.Response, error) {
 		}
 	}(res.Body)
 
+	if res.StatusCode == 404 {
+		return nil, errno.NotFound("async search %s not found", taskID)
+	}
 	if res.IsError() {
-		return nil, fmt.Errorf("es error  GetAsyncSearchResult: [%s] [%s] %s", res.Status(), s.IndexName, taskID)
+		return nil, errno.UpstreamStatus("async_search_get", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, taskID))
 	}
 
-	// 输出返回的响应体
-	return res, nil
+	var r AsyncSearchResponse
+	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+		return nil, err
+	}
+	setSearchResult(span, r.Response.Took, r.Response.Hits.Total.Value)
+
+	return &r, nil
 }
 
 // CancelAsyncSearch 取消异步搜索
-func (s *Store) CancelAsyncSearch(taskID string) (bool, error) {
+func (s *Store) CancelAsyncSearch(ctx context.Context, taskID string) (_ bool, err error) {
+	ctx, span := s.startSpan(ctx, "async_search_delete", 0)
+	defer func() { endSpan(span, err) }()
 	res, err := s.es.AsyncSearch.Delete(
 		taskID,
-		s.es.AsyncSearch.Delete.WithContext(context.Background()),
+		s.es.AsyncSearch.Delete.WithContext(ctx),
 	)
 	if err != nil {
-		return false, fmt.Errorf("es error CancelAsyncSearch: %s", err.Error())
+		return false, errno.Upstream("async_search_delete", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -738,10 +860,45 @@ func (s *Store) CancelAsyncSearch(taskID string) (bool, error) {
 		}
 	}(res.Body)
 
+	if res.StatusCode == 404 {
+		return false, errno.NotFound("async search %s not found", taskID)
+	}
 	if res.IsError() {
-		return false, fmt.Errorf("es error CancelAsyncSearch: [%s] [%s] %s", res.Status(), s.IndexName, taskID)
+		return false, errno.UpstreamStatus("async_search_delete", res.StatusCode, fmt.Sprintf("[%s] %s", s.IndexName, taskID))
 	}
 
 	// 输出返回的响应体
 	return true, nil
 }
+
+// Health 检查索引所在集群的健康状态, red 时返回错误
+func (s *Store) Health(ctx context.Context) error {
+	res, err := s.es.Cluster.Health(
+		s.es.Cluster.Health.WithContext(ctx),
+		s.es.Cluster.Health.WithIndex(s.IndexName),
+	)
+	if err != nil {
+		return fmt.Errorf("es error cluster health: %s", err.Error())
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			log.Printf("failed to close body: %v", err)
+		}
+	}(res.Body)
+
+	if res.IsError() {
+		return fmt.Errorf("es error cluster health: [%s] [%s]", res.Status(), s.IndexName)
+	}
+
+	var r struct {
+		Status string `json:"status"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&r); err != nil {
+		return err
+	}
+	if r.Status == "red" {
+		return fmt.Errorf("es error cluster health is red: [%s]", s.IndexName)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package easyes
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("easyms-es/easyes")
+
+// startSpan 开始一次Store操作的span, dslSize 为请求体的字节数
+func (s *Store) startSpan(ctx context.Context, operation string, dslSize int) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "elasticsearch "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemElasticsearch,
+			semconv.DBOperation(operation),
+			attribute.String("db.elasticsearch.index", s.IndexName),
+			attribute.Int("db.elasticsearch.dsl_size", dslSize),
+		),
+	)
+}
+
+// endSpan 结束span, 出错时标记失败
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// setSearchResult 记录搜索的耗时(took, 毫秒)及命中总数
+func setSearchResult(span trace.Span, took int, hits int) {
+	span.SetAttributes(
+		attribute.Int("db.elasticsearch.took", took),
+		attribute.Int("db.elasticsearch.hits", hits),
+	)
+}
@@ -0,0 +1,286 @@
+// Package errno 统一的错误模型, 带类型的错误映射为标准gRPC状态码(网关按状态码返回HTTP状态),
+// 并附带 ErrorInfo/RetryInfo 详情; 内部原因(如Elasticsearch响应)只记录日志, 不返回给客户端
+package errno
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Domain ErrorInfo 的错误域
+const Domain = "easyms-es"
+
+// UpstreamRetryAfter 上游服务暂不可用时建议的重试等待时间
+var UpstreamRetryAfter = 2 * time.Second
+
+// Kind 错误类型
+type Kind int
+
+// 预定义错误类型, 注释为对应的gRPC状态码及网关HTTP状态码
+const (
+	KindInternal         Kind = iota // Internal, 500
+	KindInvalidParam                 // InvalidArgument, 400
+	KindNotFound                     // NotFound, 404
+	KindTimeout                      // DeadlineExceeded, 504
+	KindCanceled                     // Canceled, 499
+	KindUpstream                     // 可重试时 Unavailable, 503, 否则 Internal, 500
+	KindUnauthenticated              // Unauthenticated, 401
+	KindPermissionDenied             // PermissionDenied, 403
+	KindRateLimited                  // ResourceExhausted, 429
+)
+
+// Error 带类型的错误, Error() 包含内部原因用于日志, 客户端只收到 message 及详情
+type Error struct {
+	kind       Kind
+	message    string
+	operation  string        // 失败的上游操作, 写入 ErrorInfo
+	retryAfter time.Duration // 大于0时返回 RetryInfo
+	details    []protoadapt.MessageV1
+	cause      error
+}
+
+// InvalidParam 参数错误
+func InvalidParam(format string, args ...interface{}) *Error {
+	return &Error{kind: KindInvalidParam, message: fmt.Sprintf(format, args...)}
+}
+
+// NotFound 资源不存在
+func NotFound(format string, args ...interface{}) *Error {
+	return &Error{kind: KindNotFound, message: fmt.Sprintf(format, args...)}
+}
+
+// Timeout 操作超时
+func Timeout(format string, args ...interface{}) *Error {
+	return &Error{kind: KindTimeout, message: fmt.Sprintf(format, args...)}
+}
+
+// Unauthenticated 认证失败
+func Unauthenticated(message string) *Error {
+	return &Error{kind: KindUnauthenticated, message: message}
+}
+
+// PermissionDenied 无权调用
+func PermissionDenied(message string) *Error {
+	return &Error{kind: KindPermissionDenied, message: message}
+}
+
+// RateLimited 超出限流或配额, retryAfter 为建议的重试等待时间
+func RateLimited(message string, retryAfter time.Duration) *Error {
+	return &Error{kind: KindRateLimited, message: message, retryAfter: retryAfter}
+}
+
+// Internal 内部错误, cause 只记录日志
+func Internal(cause error) *Error {
+	return &Error{kind: KindInternal, message: "internal error", cause: cause}
+}
+
+// Upstream 上游请求未完成(连接失败、超时等), 超时及取消分别归为 Timeout、Canceled
+// 参数:
+//
+//	operation - 上游操作, 如 search
+//	cause - 底层错误, 只记录日志
+func Upstream(operation string, cause error) *Error {
+	e := &Error{kind: KindUpstream, message: "search engine unavailable", operation: operation, retryAfter: UpstreamRetryAfter, cause: cause}
+	var timeout interface{ Timeout() bool }
+	switch {
+	case errors.Is(cause, context.Canceled):
+		e.kind, e.message, e.retryAfter = KindCanceled, "request canceled", 0
+	case errors.Is(cause, context.DeadlineExceeded), errors.As(cause, &timeout) && timeout.Timeout():
+		e.kind, e.message = KindTimeout, "search engine request timed out"
+	}
+	return e
+}
+
+// UpstreamStatus 上游返回错误状态码, 429 及 502-504 可重试, 其他状态码(如DSL错误)为内部错误
+// 参数:
+//
+//	operation - 上游操作
+//	statusCode - 上游响应的HTTP状态码
+//	detail - 响应内容, 只记录日志
+func UpstreamStatus(operation string, statusCode int, detail string) *Error {
+	e := &Error{kind: KindUpstream, message: "search engine error", operation: operation, cause: fmt.Errorf("[%d] %s", statusCode, detail)}
+	switch statusCode {
+	case 429, 502, 503, 504:
+		e.message, e.retryAfter = "search engine unavailable", UpstreamRetryAfter
+	}
+	return e
+}
+
+// WithCause 记录内部原因, 不返回给客户端
+func (e *Error) WithCause(cause error) *Error {
+	e.cause = cause
+	return e
+}
+
+// WithDetails 附加错误详情, 如参数校验的 BadRequest
+func (e *Error) WithDetails(details ...protoadapt.MessageV1) *Error {
+	e.details = append(e.details, details...)
+	return e
+}
+
+// Kind 错误类型
+func (e *Error) Kind() Kind {
+	return e.kind
+}
+
+// Message 返回给客户端的错误信息
+func (e *Error) Message() string {
+	return e.message
+}
+
+// Error 错误信息及内部原因
+func (e *Error) Error() string {
+	msg := e.message
+	if len(e.operation) > 0 {
+		msg = e.operation + ": " + msg
+	}
+	if e.cause != nil {
+		msg += ": " + e.cause.Error()
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// GRPCStatus 转为gRPC状态, 附带 ErrorInfo, 可重试时附带 RetryInfo
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(e.code(), e.message)
+	info := &errdetails.ErrorInfo{Reason: e.reason(), Domain: Domain}
+	if len(e.operation) > 0 {
+		info.Metadata = map[string]string{"operation": e.operation}
+	}
+	details := []protoadapt.MessageV1{info}
+	if e.retryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(e.retryAfter)})
+	}
+	details = append(details, e.details...)
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+// code gRPC状态码
+func (e *Error) code() codes.Code {
+	switch e.kind {
+	case KindInvalidParam:
+		return codes.InvalidArgument
+	case KindNotFound:
+		return codes.NotFound
+	case KindTimeout:
+		return codes.DeadlineExceeded
+	case KindCanceled:
+		return codes.Canceled
+	case KindUpstream:
+		if e.retryAfter > 0 {
+			return codes.Unavailable
+		}
+		return codes.Internal
+	case KindUnauthenticated:
+		return codes.Unauthenticated
+	case KindPermissionDenied:
+		return codes.PermissionDenied
+	case KindRateLimited:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
+
+// reason ErrorInfo 的错误原因
+func (e *Error) reason() string {
+	switch e.kind {
+	case KindInvalidParam:
+		return "INVALID_PARAM"
+	case KindNotFound:
+		return "NOT_FOUND"
+	case KindTimeout:
+		return "TIMEOUT"
+	case KindCanceled:
+		return "CANCELED"
+	case KindUpstream:
+		if e.retryAfter > 0 {
+			return "UPSTREAM_UNAVAILABLE"
+		}
+		return "UPSTREAM_ERROR"
+	case KindUnauthenticated:
+		return "UNAUTHENTICATED"
+	case KindPermissionDenied:
+		return "PERMISSION_DENIED"
+	case KindRateLimited:
+		return "RATE_LIMITED"
+	default:
+		return "INTERNAL"
+	}
+}
+
+// KindOf 错误类型, 未分类的错误为 KindInternal
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.kind
+	}
+	return KindInternal
+}
+
+// Is 是否为指定类型的错误
+func Is(err error, kind Kind) bool {
+	var e *Error
+	return errors.As(err, &e) && e.kind == kind
+}
+
+// HandleError 统一处理错误并返回gRPC格式错误
+// 带类型的错误按类型转换(包括被包装的), 已是gRPC状态错误时保留, 上下文超时及取消转为对应状态码,
+// 其他未分类的错误为 Internal, 不返回原始错误信息
+// 参数:
+//
+//	err - 错误对象
+//
+// 返回:
+//
+//	error - gRPC格式错误
+func HandleError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return e.GRPCStatus().Err()
+	}
+	if _, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return Timeout("request timed out").GRPCStatus().Err()
+	case errors.Is(err, context.Canceled):
+		return (&Error{kind: KindCanceled, message: "request canceled"}).GRPCStatus().Err()
+	}
+	return Internal(err).GRPCStatus().Err()
+}
+
+// Detail 日志中记录的错误信息, 带类型的错误包含内部原因, 未分类的错误为原始信息
+func Detail(err error) string {
+	if err == nil {
+		return ""
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return err.Error()
+	}
+	if st, ok := status.FromError(err); ok {
+		return st.Message()
+	}
+	return err.Error()
+}
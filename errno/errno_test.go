@@ -0,0 +1,168 @@
+package errno
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// timeoutError 实现 Timeout() 的网络错误
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+var _ net.Error = timeoutError{}
+
+func TestGRPCStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           *Error
+		wantKind      Kind
+		wantCode      codes.Code
+		wantReason    string
+		wantMessage   string
+		wantOperation string
+		wantRetry     time.Duration
+	}{
+		{name: "invalid param", err: InvalidParam("size is error: %d", -1), wantKind: KindInvalidParam, wantCode: codes.InvalidArgument, wantReason: "INVALID_PARAM", wantMessage: "size is error: -1"},
+		{name: "not found", err: NotFound("product %d", 1), wantKind: KindNotFound, wantCode: codes.NotFound, wantReason: "NOT_FOUND", wantMessage: "product 1"},
+		{name: "timeout", err: Timeout("export timed out"), wantKind: KindTimeout, wantCode: codes.DeadlineExceeded, wantReason: "TIMEOUT", wantMessage: "export timed out"},
+		{name: "unauthenticated", err: Unauthenticated("invalid token"), wantKind: KindUnauthenticated, wantCode: codes.Unauthenticated, wantReason: "UNAUTHENTICATED", wantMessage: "invalid token"},
+		{name: "permission denied", err: PermissionDenied("permission denied"), wantKind: KindPermissionDenied, wantCode: codes.PermissionDenied, wantReason: "PERMISSION_DENIED", wantMessage: "permission denied"},
+		{name: "rate limited", err: RateLimited("rate limit exceeded", 3*time.Second), wantKind: KindRateLimited, wantCode: codes.ResourceExhausted, wantReason: "RATE_LIMITED", wantMessage: "rate limit exceeded", wantRetry: 3 * time.Second},
+		{name: "internal hides cause", err: Internal(errors.New("dsl: [query] unknown field")), wantKind: KindInternal, wantCode: codes.Internal, wantReason: "INTERNAL", wantMessage: "internal error"},
+		{name: "upstream unavailable", err: Upstream("search", errors.New("connection refused")), wantKind: KindUpstream, wantCode: codes.Unavailable, wantReason: "UPSTREAM_UNAVAILABLE", wantMessage: "search engine unavailable", wantOperation: "search", wantRetry: UpstreamRetryAfter},
+		{name: "upstream deadline", err: Upstream("search", fmt.Errorf("perform: %w", context.DeadlineExceeded)), wantKind: KindTimeout, wantCode: codes.DeadlineExceeded, wantReason: "TIMEOUT", wantMessage: "search engine request timed out", wantOperation: "search", wantRetry: UpstreamRetryAfter},
+		{name: "upstream network timeout", err: Upstream("bulk", timeoutError{}), wantKind: KindTimeout, wantCode: codes.DeadlineExceeded, wantReason: "TIMEOUT", wantMessage: "search engine request timed out", wantOperation: "bulk", wantRetry: UpstreamRetryAfter},
+		{name: "upstream canceled", err: Upstream("search", context.Canceled), wantKind: KindCanceled, wantCode: codes.Canceled, wantReason: "CANCELED", wantMessage: "request canceled", wantOperation: "search"},
+		{name: "upstream 429", err: UpstreamStatus("search", 429, "too many requests"), wantKind: KindUpstream, wantCode: codes.Unavailable, wantReason: "UPSTREAM_UNAVAILABLE", wantMessage: "search engine unavailable", wantOperation: "search", wantRetry: UpstreamRetryAfter},
+		{name: "upstream 503", err: UpstreamStatus("msearch", 503, "unavailable"), wantKind: KindUpstream, wantCode: codes.Unavailable, wantReason: "UPSTREAM_UNAVAILABLE", wantMessage: "search engine unavailable", wantOperation: "msearch", wantRetry: UpstreamRetryAfter},
+		{name: "upstream 400", err: UpstreamStatus("search", 400, "parsing_exception"), wantKind: KindUpstream, wantCode: codes.Internal, wantReason: "UPSTREAM_ERROR", wantMessage: "search engine error", wantOperation: "search"},
+		{name: "upstream 404", err: UpstreamStatus("get", 404, "index_not_found"), wantKind: KindUpstream, wantCode: codes.Internal, wantReason: "UPSTREAM_ERROR", wantMessage: "search engine error", wantOperation: "get"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.err.Kind() != tt.wantKind {
+				t.Fatalf("Kind() = %v, want %v", tt.err.Kind(), tt.wantKind)
+			}
+			st := tt.err.GRPCStatus()
+			if st.Code() != tt.wantCode || st.Message() != tt.wantMessage {
+				t.Fatalf("GRPCStatus() = %v %q, want %v %q", st.Code(), st.Message(), tt.wantCode, tt.wantMessage)
+			}
+
+			var info *errdetails.ErrorInfo
+			var retry *errdetails.RetryInfo
+			for _, detail := range st.Details() {
+				switch d := detail.(type) {
+				case *errdetails.ErrorInfo:
+					info = d
+				case *errdetails.RetryInfo:
+					retry = d
+				}
+			}
+			if info == nil || info.Reason != tt.wantReason || info.Domain != Domain || info.Metadata["operation"] != tt.wantOperation {
+				t.Fatalf("GRPCStatus() ErrorInfo = %v, want reason %s, operation %q", info, tt.wantReason, tt.wantOperation)
+			}
+			if tt.wantRetry == 0 {
+				if retry != nil {
+					t.Fatalf("GRPCStatus() RetryInfo = %v, want none", retry)
+				}
+				return
+			}
+			if retry == nil || retry.RetryDelay.AsDuration() != tt.wantRetry {
+				t.Fatalf("GRPCStatus() RetryInfo = %v, want %v", retry, tt.wantRetry)
+			}
+		})
+	}
+}
+
+func TestHandleError(t *testing.T) {
+	typed := InvalidParam("from is error")
+	existing := status.Error(codes.NotFound, "not found")
+
+	tests := []struct {
+		name        string
+		err         error
+		wantCode    codes.Code
+		wantMessage string
+	}{
+		{name: "nil", err: nil, wantCode: codes.OK},
+		{name: "typed", err: typed, wantCode: codes.InvalidArgument, wantMessage: "from is error"},
+		{name: "wrapped typed", err: fmt.Errorf("search: %w", typed), wantCode: codes.InvalidArgument, wantMessage: "from is error"},
+		{name: "grpc status kept", err: existing, wantCode: codes.NotFound, wantMessage: "not found"},
+		{name: "deadline", err: fmt.Errorf("search: %w", context.DeadlineExceeded), wantCode: codes.DeadlineExceeded, wantMessage: "request timed out"},
+		{name: "canceled", err: context.Canceled, wantCode: codes.Canceled, wantMessage: "request canceled"},
+		{name: "unclassified hidden", err: errors.New("sql: password=secret"), wantCode: codes.Internal, wantMessage: "internal error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := HandleError(tt.err)
+			if tt.err == nil {
+				if err != nil {
+					t.Fatalf("HandleError(nil) = %v", err)
+				}
+				return
+			}
+			st, ok := status.FromError(err)
+			if !ok || st.Code() != tt.wantCode || st.Message() != tt.wantMessage {
+				t.Fatalf("HandleError() = %v, want %v %q", err, tt.wantCode, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestErrorAndDetail(t *testing.T) {
+	cause := errors.New("[500] es error")
+	err := UpstreamStatus("search", 500, "es error")
+
+	if got := err.Error(); got != "search: search engine error: [500] es error" {
+		t.Fatalf("Error() = %q", got)
+	}
+	if got := Detail(fmt.Errorf("wrapped: %w", err)); got != "wrapped: search: search engine error: [500] es error" {
+		t.Fatalf("Detail() = %q", got)
+	}
+	if got := Detail(status.Error(codes.NotFound, "missing")); got != "missing" {
+		t.Fatalf("Detail(status) = %q", got)
+	}
+	if got := Detail(nil); got != "" {
+		t.Fatalf("Detail(nil) = %q", got)
+	}
+
+	internal := Internal(cause)
+	if !errors.Is(internal, cause) {
+		t.Fatal("Internal() does not unwrap to its cause")
+	}
+	if !Is(fmt.Errorf("wrapped: %w", internal), KindInternal) || Is(internal, KindUpstream) {
+		t.Fatal("Is() does not match the error kind")
+	}
+	if KindOf(errors.New("plain")) != KindInternal || KindOf(RateLimited("limited", time.Second)) != KindRateLimited {
+		t.Fatal("KindOf() does not match the error kind")
+	}
+}
+
+func TestWithDetails(t *testing.T) {
+	err := InvalidParam("size is error").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: "Size", Description: "must be positive"}},
+	})
+
+	var badRequest *errdetails.BadRequest
+	for _, detail := range err.GRPCStatus().Details() {
+		if d, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = d
+		}
+	}
+	if badRequest == nil || len(badRequest.FieldViolations) != 1 || badRequest.FieldViolations[0].Field != "Size" {
+		t.Fatalf("GRPCStatus() BadRequest = %v", badRequest)
+	}
+}
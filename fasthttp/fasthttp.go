@@ -15,6 +15,7 @@ import (
 type Transport struct {
 	TLSClientConfig *tls.Config // TLS配置
 	Client          *fasthttp.Client // fasthttp客户端实例
+	Observe         func(req *http.Request, statusCode int, err error, latency time.Duration) // 请求完成回调, 用于记录指标
 }
 
 // NewTransport 创建带TLS配置的传输层
@@ -58,7 +59,12 @@ func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 		return nil, err
 	}
 
-	if err := t.Client.Do(freq, resp); err != nil {
+	start := time.Now()
+	err := t.Client.Do(freq, resp)
+	if t.Observe != nil {
+		t.Observe(req, resp.StatusCode(), err, time.Since(start))
+	}
+	if err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,119 @@
+// Package metrics Prometheus 指标, 由 /metrics 接口导出
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "easyes"
+
+var (
+	// GrpcRequests gRPC请求数, code 为gRPC状态码名称, 如 OK、InvalidArgument、Unavailable
+	GrpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "gRPC requests by method and code.",
+	}, []string{"method", "code"})
+
+	// GrpcLatency gRPC请求耗时
+	GrpcLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "gRPC request latency by method and code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	// EsLatency Elasticsearch请求耗时, status 为HTTP状态码, 请求失败时为 error
+	EsLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "elasticsearch",
+		Name:      "request_duration_seconds",
+		Help:      "Elasticsearch request latency by operation, index and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "index", "status"})
+
+	// LoggerDropped 日志队列已满时丢弃的访问日志数
+	LoggerDropped = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "logger",
+		Name:      "dropped_total",
+		Help:      "Access log entries dropped because the queue was full.",
+	})
+
+	// RatelimitFallback redis不可用时改用进程内计数的次数, operation 为 take/incr, 大于0时限流配额只在单实例内生效
+	RatelimitFallback = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ratelimit",
+		Name:      "store_fallback_total",
+		Help:      "Rate limit checks counted in process memory because redis failed, by operation.",
+	}, []string{"operation"})
+
+	// JobDuration 任务单次运行耗时, result 为 success/failure
+	JobDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "job",
+		Name:      "run_duration_seconds",
+		Help:      "Job run duration by job and result.",
+		Buckets:   []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800, 3600},
+	}, []string{"job", "result"})
+
+	// JobItemsIndexed 任务写入索引的文档数
+	JobItemsIndexed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "job",
+		Name:      "items_indexed_total",
+		Help:      "Documents indexed by job.",
+	}, []string{"job"})
+
+	// JobFailures 任务失败次数
+	JobFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "job",
+		Name:      "failures_total",
+		Help:      "Job failures by job.",
+	}, []string{"job"})
+
+	// JobLastSuccess 任务最近一次成功的时间戳
+	JobLastSuccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "job",
+		Name:      "last_success_timestamp_seconds",
+		Help:      "Unix time of the last successful run by job.",
+	}, []string{"job"})
+)
+
+func init() {
+	prometheus.MustRegister(GrpcRequests, GrpcLatency, EsLatency, LoggerDropped, RatelimitFallback,
+		JobDuration, JobItemsIndexed, JobFailures, JobLastSuccess)
+}
+
+// Handler /metrics 接口
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// RegisterLoggerQueue 注册日志队列长度, 由日志系统初始化时调用
+func RegisterLoggerQueue(depth func() int) {
+	prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "logger",
+		Name:      "queue_depth",
+		Help:      "Access log entries waiting to be written.",
+	}, func() float64 {
+		return float64(depth())
+	}))
+}
+
+// ObserveGrpc 记录一次gRPC请求的结果及耗时
+func ObserveGrpc(fullMethod string, err error, startTime time.Time) {
+	code := status.Code(err).String()
+	GrpcRequests.WithLabelValues(fullMethod, code).Inc()
+	GrpcLatency.WithLabelValues(fullMethod, code).Observe(time.Since(startTime).Seconds())
+}
@@ -73,4 +73,49 @@ type StockPrice struct {
 
 	UpdateTime time.Time `json:"UpdateTime,omitempty" es:"type:date"`
 	Sort       int       `json:"Sort" es:"type:integer"`
+
+	// 原始阶梯价格及起订量, 用于按数量报价
+	MOQ         int          `json:"MOQ,omitempty" es:"type:integer"`       // 最小起订量
+	Multiples   int          `json:"Multiples,omitempty" es:"type:integer"` // 订购倍数
+	PriceBreaks []PriceBreak `json:"PriceBreaks,omitempty" es:"type:nested"`
+}
+
+// PriceBreak 阶梯价格, 数量达到 Qty 时的单价
+type PriceBreak struct {
+	Qty   int    `json:"Qty" es:"type:integer"`
+	Price string `json:"Price" es:"type:keyword"`
+}
+
+// ProductSource 产品源数据, 来自产品表或上游系统推送, 由 products.BuildProduct 生成索引文档
+type ProductSource struct {
+	PID         int
+	ProductName string
+	Brand       string
+	BrandID     int
+	CategoryID  int
+	ParentID    int
+}
+
+// StepPrice 源数据中的阶梯价格
+type StepPrice struct {
+	Qty   int
+	Price float64
+}
+
+// PriceSource 价格源数据, 来自价格表或上游系统推送, 由 prices.BuildStockPrice 生成索引文档
+type PriceSource struct {
+	SID                   int
+	PID                   int
+	DistributorType       int
+	DistributorID         int
+	Distributor           string
+	DistributorProductUrl string
+	ProductName           string
+	Brand                 string
+	StockNum              int
+	Currency              string
+	StepPrices            []StepPrice
+	MOQ                   int
+	Multiples             int
+	UpdateTime            time.Time
 }
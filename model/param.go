@@ -2,6 +2,7 @@ package model
 
 // ProductSearchParam 产品搜索参数
 type ProductSearchParam struct {
+	KeyWord      string `json:"KeyWord,omitempty"`
 	ProductName  string `json:"ProductName,omitempty"`
 	Brand        string `json:"Brand,omitempty"`
 	Category     string `json:"Category,omitempty"`
@@ -69,6 +70,69 @@ type CustomSearchProductParam struct {
 
 type CustomRange struct {
 	Field string
-	Gte   int32
-	Lte   int32
+	Gte   *int32 // 为nil时不限制
+	Lte   *int32 // 为nil时不限制
+}
+
+// ExportProductParam 产品导出参数, ResumeToken 为上次中断时返回的续传令牌
+type ExportProductParam struct {
+	BrandID       int32
+	ParentID      int32
+	CategoryID    int32
+	DistributorID int32
+	ChunkSize     int32
+	ResumeToken   string
+}
+
+// ExportPriceParam 价格导出参数, ResumeToken 为上次中断时返回的续传令牌
+type ExportPriceParam struct {
+	BrandID       int32
+	DistributorID int32
+	ChunkSize     int32
+	ResumeToken   string
+}
+
+// PriceSortType 价格排序方式
+type PriceSortType int32
+
+const (
+	PriceSortFreshness    PriceSortType = 0 // 更新时间及排序权重
+	PriceSortLowestPrice  PriceSortType = 1 // 指定阶梯的最低价格
+	PriceSortHighestStock PriceSortType = 2 // 库存最多
+)
+
+// PriceSearchParam 单产品价格搜索参数
+type PriceSearchParam struct {
+	PID             int32
+	InStock         bool
+	AuthorizedOnly  bool
+	DistributorType int32 // 0:全部；1：入驻的分销商；2：非入驻分销商
+	DistributorIDs  []int32
+	Currencies      []string
+	MinStock        int32
+	SortType        PriceSortType
+	PriceTier       int32 // 1-5 对应 StepPrice1-StepPrice5
+	TargetCurrency  string
+	Size            int32
+	From            int32
+}
+
+// QuoteParam 按数量报价参数, 过滤条件与价格搜索一致
+type QuoteParam struct {
+	PriceSearchParam
+	Quantity int32
+}
+
+// BomRow BOM中的一行
+type BomRow struct {
+	PartNumber   string
+	Manufacturer string
+	Quantity     int32
+}
+
+// BomMatchParam BOM批量匹配参数, TopOffers 为每行返回的报价数量
+type BomMatchParam struct {
+	Rows           []BomRow
+	TopOffers      int32
+	TargetCurrency string
 }
@@ -0,0 +1,934 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.22.0
+// source: protos/messages/admin.proto
+
+package messages
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 产品源数据, 与产品表字段一致
+type ProductDoc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PID         int32  `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
+	ProductName string `protobuf:"bytes,2,opt,name=ProductName,proto3" json:"ProductName,omitempty"`
+	Brand       string `protobuf:"bytes,3,opt,name=Brand,proto3" json:"Brand,omitempty"`
+	BrandID     int32  `protobuf:"varint,4,opt,name=BrandID,proto3" json:"BrandID,omitempty"`
+	CategoryID  int32  `protobuf:"varint,5,opt,name=CategoryID,proto3" json:"CategoryID,omitempty"`
+	ParentID    int32  `protobuf:"varint,6,opt,name=ParentID,proto3" json:"ParentID,omitempty"`
+}
+
+func (x *ProductDoc) Reset() {
+	*x = ProductDoc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_admin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductDoc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductDoc) ProtoMessage() {}
+
+func (x *ProductDoc) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_admin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductDoc.ProtoReflect.Descriptor instead.
+func (*ProductDoc) Descriptor() ([]byte, []int) {
+	return file_protos_messages_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProductDoc) GetPID() int32 {
+	if x != nil {
+		return x.PID
+	}
+	return 0
+}
+
+func (x *ProductDoc) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ProductDoc) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *ProductDoc) GetBrandID() int32 {
+	if x != nil {
+		return x.BrandID
+	}
+	return 0
+}
+
+func (x *ProductDoc) GetCategoryID() int32 {
+	if x != nil {
+		return x.CategoryID
+	}
+	return 0
+}
+
+func (x *ProductDoc) GetParentID() int32 {
+	if x != nil {
+		return x.ParentID
+	}
+	return 0
+}
+
+// 源数据中的阶梯价格
+type StepPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Qty   int32   `protobuf:"varint,1,opt,name=Qty,proto3" json:"Qty,omitempty"`
+	Price float64 `protobuf:"fixed64,2,opt,name=Price,proto3" json:"Price,omitempty"`
+}
+
+func (x *StepPrice) Reset() {
+	*x = StepPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_admin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StepPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepPrice) ProtoMessage() {}
+
+func (x *StepPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_admin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepPrice.ProtoReflect.Descriptor instead.
+func (*StepPrice) Descriptor() ([]byte, []int) {
+	return file_protos_messages_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StepPrice) GetQty() int32 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+func (x *StepPrice) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// 价格源数据, DistributorType 1:入驻分销商(SID必填) 2:非入驻分销商, UpdateTime 为 RFC3339 格式
+type PriceDoc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SID                   int32        `protobuf:"varint,1,opt,name=SID,proto3" json:"SID,omitempty"`
+	PID                   int32        `protobuf:"varint,2,opt,name=PID,proto3" json:"PID,omitempty"`
+	DistributorType       int32        `protobuf:"varint,3,opt,name=DistributorType,proto3" json:"DistributorType,omitempty"`
+	DistributorID         int32        `protobuf:"varint,4,opt,name=DistributorID,proto3" json:"DistributorID,omitempty"`
+	Distributor           string       `protobuf:"bytes,5,opt,name=Distributor,proto3" json:"Distributor,omitempty"`
+	DistributorProductUrl string       `protobuf:"bytes,6,opt,name=DistributorProductUrl,proto3" json:"DistributorProductUrl,omitempty"`
+	ProductName           string       `protobuf:"bytes,7,opt,name=ProductName,proto3" json:"ProductName,omitempty"`
+	Brand                 string       `protobuf:"bytes,8,opt,name=Brand,proto3" json:"Brand,omitempty"`
+	StockNum              int32        `protobuf:"varint,9,opt,name=StockNum,proto3" json:"StockNum,omitempty"`
+	Currency              string       `protobuf:"bytes,10,opt,name=Currency,proto3" json:"Currency,omitempty"`
+	StepPrices            []*StepPrice `protobuf:"bytes,11,rep,name=StepPrices,proto3" json:"StepPrices,omitempty"`
+	MOQ                   int32        `protobuf:"varint,12,opt,name=MOQ,proto3" json:"MOQ,omitempty"`
+	Multiples             int32        `protobuf:"varint,13,opt,name=Multiples,proto3" json:"Multiples,omitempty"`
+	UpdateTime            string       `protobuf:"bytes,14,opt,name=UpdateTime,proto3" json:"UpdateTime,omitempty"`
+}
+
+func (x *PriceDoc) Reset() {
+	*x = PriceDoc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_admin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceDoc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceDoc) ProtoMessage() {}
+
+func (x *PriceDoc) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_admin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceDoc.ProtoReflect.Descriptor instead.
+func (*PriceDoc) Descriptor() ([]byte, []int) {
+	return file_protos_messages_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PriceDoc) GetSID() int32 {
+	if x != nil {
+		return x.SID
+	}
+	return 0
+}
+
+func (x *PriceDoc) GetPID() int32 {
+	if x != nil {
+		return x.PID
+	}
+	return 0
+}
+
+func (x *PriceDoc) GetDistributorType() int32 {
+	if x != nil {
+		return x.DistributorType
+	}
+	return 0
+}
+
+func (x *PriceDoc) GetDistributorID() int32 {
+	if x != nil {
+		return x.DistributorID
+	}
+	return 0
+}
+
+func (x *PriceDoc) GetDistributor() string {
+	if x != nil {
+		return x.Distributor
+	}
+	return ""
+}
+
+func (x *PriceDoc) GetDistributorProductUrl() string {
+	if x != nil {
+		return x.DistributorProductUrl
+	}
+	return ""
+}
+
+func (x *PriceDoc) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *PriceDoc) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *PriceDoc) GetStockNum() int32 {
+	if x != nil {
+		return x.StockNum
+	}
+	return 0
+}
+
+func (x *PriceDoc) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PriceDoc) GetStepPrices() []*StepPrice {
+	if x != nil {
+		return x.StepPrices
+	}
+	return nil
+}
+
+func (x *PriceDoc) GetMOQ() int32 {
+	if x != nil {
+		return x.MOQ
+	}
+	return 0
+}
+
+func (x *PriceDoc) GetMultiples() int32 {
+	if x != nil {
+		return x.Multiples
+	}
+	return 0
+}
+
+func (x *PriceDoc) GetUpdateTime() string {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return ""
+}
+
+// 写入产品, Refresh 为刷新策略 false/true/wait_for, 不传使用服务端配置
+type UpsertProductsParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Products []*ProductDoc `protobuf:"bytes,1,rep,name=Products,proto3" json:"Products,omitempty"`
+	Refresh  string        `protobuf:"bytes,2,opt,name=Refresh,proto3" json:"Refresh,omitempty"`
+}
+
+func (x *UpsertProductsParam) Reset() {
+	*x = UpsertProductsParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_admin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertProductsParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertProductsParam) ProtoMessage() {}
+
+func (x *UpsertProductsParam) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_admin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertProductsParam.ProtoReflect.Descriptor instead.
+func (*UpsertProductsParam) Descriptor() ([]byte, []int) {
+	return file_protos_messages_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpsertProductsParam) GetProducts() []*ProductDoc {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *UpsertProductsParam) GetRefresh() string {
+	if x != nil {
+		return x.Refresh
+	}
+	return ""
+}
+
+type DeleteProductsParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PIDs    []int32 `protobuf:"varint,1,rep,packed,name=PIDs,proto3" json:"PIDs,omitempty"`
+	Refresh string  `protobuf:"bytes,2,opt,name=Refresh,proto3" json:"Refresh,omitempty"`
+}
+
+func (x *DeleteProductsParam) Reset() {
+	*x = DeleteProductsParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_admin_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProductsParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductsParam) ProtoMessage() {}
+
+func (x *DeleteProductsParam) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_admin_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductsParam.ProtoReflect.Descriptor instead.
+func (*DeleteProductsParam) Descriptor() ([]byte, []int) {
+	return file_protos_messages_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteProductsParam) GetPIDs() []int32 {
+	if x != nil {
+		return x.PIDs
+	}
+	return nil
+}
+
+func (x *DeleteProductsParam) GetRefresh() string {
+	if x != nil {
+		return x.Refresh
+	}
+	return ""
+}
+
+type UpsertPricesParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prices  []*PriceDoc `protobuf:"bytes,1,rep,name=Prices,proto3" json:"Prices,omitempty"`
+	Refresh string      `protobuf:"bytes,2,opt,name=Refresh,proto3" json:"Refresh,omitempty"`
+}
+
+func (x *UpsertPricesParam) Reset() {
+	*x = UpsertPricesParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_admin_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertPricesParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertPricesParam) ProtoMessage() {}
+
+func (x *UpsertPricesParam) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_admin_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertPricesParam.ProtoReflect.Descriptor instead.
+func (*UpsertPricesParam) Descriptor() ([]byte, []int) {
+	return file_protos_messages_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpsertPricesParam) GetPrices() []*PriceDoc {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *UpsertPricesParam) GetRefresh() string {
+	if x != nil {
+		return x.Refresh
+	}
+	return ""
+}
+
+type DeletePricesParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SPIDs   []string `protobuf:"bytes,1,rep,name=SPIDs,proto3" json:"SPIDs,omitempty"`
+	Refresh string   `protobuf:"bytes,2,opt,name=Refresh,proto3" json:"Refresh,omitempty"`
+}
+
+func (x *DeletePricesParam) Reset() {
+	*x = DeletePricesParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_admin_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePricesParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePricesParam) ProtoMessage() {}
+
+func (x *DeletePricesParam) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_admin_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePricesParam.ProtoReflect.Descriptor instead.
+func (*DeletePricesParam) Descriptor() ([]byte, []int) {
+	return file_protos_messages_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeletePricesParam) GetSPIDs() []string {
+	if x != nil {
+		return x.SPIDs
+	}
+	return nil
+}
+
+func (x *DeletePricesParam) GetRefresh() string {
+	if x != nil {
+		return x.Refresh
+	}
+	return ""
+}
+
+// 单条写入结果, Result 为 created/updated/deleted/not_found/invalid/error
+type WriteItemResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID     string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Result string `protobuf:"bytes,2,opt,name=Result,proto3" json:"Result,omitempty"`
+	Error  string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *WriteItemResult) Reset() {
+	*x = WriteItemResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_admin_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteItemResult) ProtoMessage() {}
+
+func (x *WriteItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_admin_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteItemResult.ProtoReflect.Descriptor instead.
+func (*WriteItemResult) Descriptor() ([]byte, []int) {
+	return file_protos_messages_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WriteItemResult) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *WriteItemResult) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *WriteItemResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// 批量写入结果, Items 与请求顺序一致
+type WriteResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total     int32              `protobuf:"varint,1,opt,name=Total,proto3" json:"Total,omitempty"`
+	Succeeded int32              `protobuf:"varint,2,opt,name=Succeeded,proto3" json:"Succeeded,omitempty"`
+	Failed    int32              `protobuf:"varint,3,opt,name=Failed,proto3" json:"Failed,omitempty"`
+	Items     []*WriteItemResult `protobuf:"bytes,4,rep,name=Items,proto3" json:"Items,omitempty"`
+}
+
+func (x *WriteResult) Reset() {
+	*x = WriteResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_admin_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteResult) ProtoMessage() {}
+
+func (x *WriteResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_admin_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteResult.ProtoReflect.Descriptor instead.
+func (*WriteResult) Descriptor() ([]byte, []int) {
+	return file_protos_messages_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WriteResult) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *WriteResult) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *WriteResult) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *WriteResult) GetItems() []*WriteItemResult {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_protos_messages_admin_proto protoreflect.FileDescriptor
+
+var file_protos_messages_admin_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x44, 0x6f, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x72,
+	0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x33, 0x0a, 0x09, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x51, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x51, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xcb, 0x03, 0x0a, 0x08,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x53, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x34,
+	0x0a, 0x15, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x55, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x33, 0x0a, 0x0a, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0a, 0x53,
+	0x74, 0x65, 0x70, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x4f, 0x51,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x4d, 0x4f, 0x51, 0x12, 0x1c, 0x0a, 0x09, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x61, 0x0a, 0x13, 0x55, 0x70, 0x73,
+	0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x12, 0x30, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x44, 0x6f, 0x63, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x43, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x04, 0x50, 0x49, 0x44, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x22, 0x59, 0x0a, 0x11, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x2a, 0x0a, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x52, 0x06, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x43, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x50, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x53, 0x50, 0x49, 0x44, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x22, 0x4f, 0x0a, 0x0f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x8a, 0x01, 0x0a, 0x0b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x2f,
+	0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x42,
+	0x37, 0x5a, 0x19, 0x65, 0x61, 0x73, 0x79, 0x6d, 0x73, 0x2d, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0xaa, 0x02, 0x19, 0x47,
+	0x72, 0x70, 0x63, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protos_messages_admin_proto_rawDescOnce sync.Once
+	file_protos_messages_admin_proto_rawDescData = file_protos_messages_admin_proto_rawDesc
+)
+
+func file_protos_messages_admin_proto_rawDescGZIP() []byte {
+	file_protos_messages_admin_proto_rawDescOnce.Do(func() {
+		file_protos_messages_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_protos_messages_admin_proto_rawDescData)
+	})
+	return file_protos_messages_admin_proto_rawDescData
+}
+
+var file_protos_messages_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_protos_messages_admin_proto_goTypes = []any{
+	(*ProductDoc)(nil),          // 0: messages.ProductDoc
+	(*StepPrice)(nil),           // 1: messages.StepPrice
+	(*PriceDoc)(nil),            // 2: messages.PriceDoc
+	(*UpsertProductsParam)(nil), // 3: messages.UpsertProductsParam
+	(*DeleteProductsParam)(nil), // 4: messages.DeleteProductsParam
+	(*UpsertPricesParam)(nil),   // 5: messages.UpsertPricesParam
+	(*DeletePricesParam)(nil),   // 6: messages.DeletePricesParam
+	(*WriteItemResult)(nil),     // 7: messages.WriteItemResult
+	(*WriteResult)(nil),         // 8: messages.WriteResult
+}
+var file_protos_messages_admin_proto_depIdxs = []int32{
+	1, // 0: messages.PriceDoc.StepPrices:type_name -> messages.StepPrice
+	0, // 1: messages.UpsertProductsParam.Products:type_name -> messages.ProductDoc
+	2, // 2: messages.UpsertPricesParam.Prices:type_name -> messages.PriceDoc
+	7, // 3: messages.WriteResult.Items:type_name -> messages.WriteItemResult
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_protos_messages_admin_proto_init() }
+func file_protos_messages_admin_proto_init() {
+	if File_protos_messages_admin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protos_messages_admin_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductDoc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_admin_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*StepPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_admin_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceDoc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_admin_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*UpsertProductsParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_admin_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteProductsParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_admin_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*UpsertPricesParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_admin_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePricesParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_admin_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*WriteItemResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_admin_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*WriteResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protos_messages_admin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protos_messages_admin_proto_goTypes,
+		DependencyIndexes: file_protos_messages_admin_proto_depIdxs,
+		MessageInfos:      file_protos_messages_admin_proto_msgTypes,
+	}.Build()
+	File_protos_messages_admin_proto = out.File
+	file_protos_messages_admin_proto_rawDesc = nil
+	file_protos_messages_admin_proto_goTypes = nil
+	file_protos_messages_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,495 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.22.0
+// source: protos/messages/bom.proto
+
+package messages
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BOM中的一行, Quantity 为需求数量
+type BomRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PartNumber   string `protobuf:"bytes,1,opt,name=PartNumber,proto3" json:"PartNumber,omitempty"`
+	Manufacturer string `protobuf:"bytes,2,opt,name=Manufacturer,proto3" json:"Manufacturer,omitempty"`
+	Quantity     int32  `protobuf:"varint,3,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+}
+
+func (x *BomRow) Reset() {
+	*x = BomRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_bom_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BomRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BomRow) ProtoMessage() {}
+
+func (x *BomRow) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_bom_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BomRow.ProtoReflect.Descriptor instead.
+func (*BomRow) Descriptor() ([]byte, []int) {
+	return file_protos_messages_bom_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BomRow) GetPartNumber() string {
+	if x != nil {
+		return x.PartNumber
+	}
+	return ""
+}
+
+func (x *BomRow) GetManufacturer() string {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return ""
+}
+
+func (x *BomRow) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// BOM批量匹配参数, 最多500行, TopOffers 为每行返回的报价数量
+type MatchBOMParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows           []*BomRow `protobuf:"bytes,1,rep,name=Rows,proto3" json:"Rows,omitempty"`
+	TopOffers      int32     `protobuf:"varint,2,opt,name=TopOffers,proto3" json:"TopOffers,omitempty"`
+	TargetCurrency string    `protobuf:"bytes,3,opt,name=TargetCurrency,proto3" json:"TargetCurrency,omitempty"`
+}
+
+func (x *MatchBOMParam) Reset() {
+	*x = MatchBOMParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_bom_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchBOMParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchBOMParam) ProtoMessage() {}
+
+func (x *MatchBOMParam) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_bom_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchBOMParam.ProtoReflect.Descriptor instead.
+func (*MatchBOMParam) Descriptor() ([]byte, []int) {
+	return file_protos_messages_bom_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MatchBOMParam) GetRows() []*BomRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *MatchBOMParam) GetTopOffers() int32 {
+	if x != nil {
+		return x.TopOffers
+	}
+	return 0
+}
+
+func (x *MatchBOMParam) GetTargetCurrency() string {
+	if x != nil {
+		return x.TargetCurrency
+	}
+	return ""
+}
+
+// BOM单行匹配结果, MatchType 为 exact/prefix/fuzzy/none, Confidence 取值 0-1
+type BomLineResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line            int32         `protobuf:"varint,1,opt,name=Line,proto3" json:"Line,omitempty"`
+	Row             *BomRow       `protobuf:"bytes,2,opt,name=Row,proto3" json:"Row,omitempty"`
+	StandPartNumber string        `protobuf:"bytes,3,opt,name=StandPartNumber,proto3" json:"StandPartNumber,omitempty"`
+	MatchType       string        `protobuf:"bytes,4,opt,name=MatchType,proto3" json:"MatchType,omitempty"`
+	Confidence      float32       `protobuf:"fixed32,5,opt,name=Confidence,proto3" json:"Confidence,omitempty"`
+	Product         *ESProduct    `protobuf:"bytes,6,opt,name=Product,proto3" json:"Product,omitempty"`
+	Offers          []*PriceQuote `protobuf:"bytes,7,rep,name=Offers,proto3" json:"Offers,omitempty"`
+	Error           string        `protobuf:"bytes,8,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *BomLineResult) Reset() {
+	*x = BomLineResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_bom_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BomLineResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BomLineResult) ProtoMessage() {}
+
+func (x *BomLineResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_bom_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BomLineResult.ProtoReflect.Descriptor instead.
+func (*BomLineResult) Descriptor() ([]byte, []int) {
+	return file_protos_messages_bom_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BomLineResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *BomLineResult) GetRow() *BomRow {
+	if x != nil {
+		return x.Row
+	}
+	return nil
+}
+
+func (x *BomLineResult) GetStandPartNumber() string {
+	if x != nil {
+		return x.StandPartNumber
+	}
+	return ""
+}
+
+func (x *BomLineResult) GetMatchType() string {
+	if x != nil {
+		return x.MatchType
+	}
+	return ""
+}
+
+func (x *BomLineResult) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *BomLineResult) GetProduct() *ESProduct {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *BomLineResult) GetOffers() []*PriceQuote {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+func (x *BomLineResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// BOM批量匹配结果
+type MatchBOMResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total          int32            `protobuf:"varint,1,opt,name=Total,proto3" json:"Total,omitempty"`
+	Matched        int32            `protobuf:"varint,2,opt,name=Matched,proto3" json:"Matched,omitempty"`
+	TargetCurrency string           `protobuf:"bytes,3,opt,name=TargetCurrency,proto3" json:"TargetCurrency,omitempty"`
+	Lines          []*BomLineResult `protobuf:"bytes,4,rep,name=Lines,proto3" json:"Lines,omitempty"`
+}
+
+func (x *MatchBOMResult) Reset() {
+	*x = MatchBOMResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_bom_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchBOMResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchBOMResult) ProtoMessage() {}
+
+func (x *MatchBOMResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_bom_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchBOMResult.ProtoReflect.Descriptor instead.
+func (*MatchBOMResult) Descriptor() ([]byte, []int) {
+	return file_protos_messages_bom_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MatchBOMResult) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *MatchBOMResult) GetMatched() int32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *MatchBOMResult) GetTargetCurrency() string {
+	if x != nil {
+		return x.TargetCurrency
+	}
+	return ""
+}
+
+func (x *MatchBOMResult) GetLines() []*BomLineResult {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+var File_protos_messages_bom_proto protoreflect.FileDescriptor
+
+var file_protos_messages_bom_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2f, 0x62, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x23, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x68, 0x0a,
+	0x06, 0x42, 0x6f, 0x6d, 0x52, 0x6f, 0x77, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x61, 0x72,
+	0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x4d, 0x61, 0x6e, 0x75, 0x66,
+	0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4d,
+	0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x7b, 0x0a, 0x0d, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x42, 0x4f, 0x4d, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x24, 0x0a, 0x04, 0x52, 0x6f, 0x77, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x42, 0x6f, 0x6d, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x54, 0x6f, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x54, 0x6f, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x22, 0xa2, 0x02, 0x0a, 0x0d, 0x42, 0x6f, 0x6d, 0x4c, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x52, 0x6f,
+	0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6d, 0x52, 0x6f, 0x77, 0x52, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x28,
+	0x0a, 0x0f, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x50, 0x61,
+	0x72, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x45, 0x53, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x07, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x06, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x97, 0x01, 0x0a, 0x0e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x42, 0x4f, 0x4d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x42,
+	0x6f, 0x6d, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x4c, 0x69,
+	0x6e, 0x65, 0x73, 0x42, 0x37, 0x5a, 0x19, 0x65, 0x61, 0x73, 0x79, 0x6d, 0x73, 0x2d, 0x65, 0x73,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0xaa, 0x02, 0x19, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protos_messages_bom_proto_rawDescOnce sync.Once
+	file_protos_messages_bom_proto_rawDescData = file_protos_messages_bom_proto_rawDesc
+)
+
+func file_protos_messages_bom_proto_rawDescGZIP() []byte {
+	file_protos_messages_bom_proto_rawDescOnce.Do(func() {
+		file_protos_messages_bom_proto_rawDescData = protoimpl.X.CompressGZIP(file_protos_messages_bom_proto_rawDescData)
+	})
+	return file_protos_messages_bom_proto_rawDescData
+}
+
+var file_protos_messages_bom_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_protos_messages_bom_proto_goTypes = []any{
+	(*BomRow)(nil),         // 0: messages.BomRow
+	(*MatchBOMParam)(nil),  // 1: messages.MatchBOMParam
+	(*BomLineResult)(nil),  // 2: messages.BomLineResult
+	(*MatchBOMResult)(nil), // 3: messages.MatchBOMResult
+	(*ESProduct)(nil),      // 4: messages.ESProduct
+	(*PriceQuote)(nil),     // 5: messages.PriceQuote
+}
+var file_protos_messages_bom_proto_depIdxs = []int32{
+	0, // 0: messages.MatchBOMParam.Rows:type_name -> messages.BomRow
+	0, // 1: messages.BomLineResult.Row:type_name -> messages.BomRow
+	4, // 2: messages.BomLineResult.Product:type_name -> messages.ESProduct
+	5, // 3: messages.BomLineResult.Offers:type_name -> messages.PriceQuote
+	2, // 4: messages.MatchBOMResult.Lines:type_name -> messages.BomLineResult
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_protos_messages_bom_proto_init() }
+func file_protos_messages_bom_proto_init() {
+	if File_protos_messages_bom_proto != nil {
+		return
+	}
+	file_protos_messages_productsearch_proto_init()
+	file_protos_messages_pricesearch_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_protos_messages_bom_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*BomRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_bom_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*MatchBOMParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_bom_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*BomLineResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_bom_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*MatchBOMResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protos_messages_bom_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protos_messages_bom_proto_goTypes,
+		DependencyIndexes: file_protos_messages_bom_proto_depIdxs,
+		MessageInfos:      file_protos_messages_bom_proto_msgTypes,
+	}.Build()
+	File_protos_messages_bom_proto = out.File
+	file_protos_messages_bom_proto_rawDesc = nil
+	file_protos_messages_bom_proto_goTypes = nil
+	file_protos_messages_bom_proto_depIdxs = nil
+}
@@ -20,34 +20,857 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 价格排序方式
+type PriceSortType int32
+
+const (
+	// 更新时间及排序权重
+	PriceSortType_PRICE_SORT_FRESHNESS PriceSortType = 0
+	// 指定阶梯(PriceTier)的最低价格
+	PriceSortType_PRICE_SORT_LOWEST_PRICE PriceSortType = 1
+	// 库存最多
+	PriceSortType_PRICE_SORT_HIGHEST_STOCK PriceSortType = 2
+)
+
+// Enum value maps for PriceSortType.
+var (
+	PriceSortType_name = map[int32]string{
+		0: "PRICE_SORT_FRESHNESS",
+		1: "PRICE_SORT_LOWEST_PRICE",
+		2: "PRICE_SORT_HIGHEST_STOCK",
+	}
+	PriceSortType_value = map[string]int32{
+		"PRICE_SORT_FRESHNESS":     0,
+		"PRICE_SORT_LOWEST_PRICE":  1,
+		"PRICE_SORT_HIGHEST_STOCK": 2,
+	}
+)
+
+func (x PriceSortType) Enum() *PriceSortType {
+	p := new(PriceSortType)
+	*p = x
+	return p
+}
+
+func (x PriceSortType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceSortType) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_messages_pricesearch_proto_enumTypes[0].Descriptor()
+}
+
+func (PriceSortType) Type() protoreflect.EnumType {
+	return &file_protos_messages_pricesearch_proto_enumTypes[0]
+}
+
+func (x PriceSortType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceSortType.Descriptor instead.
+func (PriceSortType) EnumDescriptor() ([]byte, []int) {
+	return file_protos_messages_pricesearch_proto_rawDescGZIP(), []int{0}
+}
+
 // 单型号下的产品价格搜索参数
+// DistributorType 0:全部 1:入驻分销商 2:非入驻分销商, PriceTier 1-5 对应 Price1-Price5
 type PriceSearchParam struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PID  int32 `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
-	Size int32 `protobuf:"varint,2,opt,name=Size,proto3" json:"Size,omitempty"`
-	From int32 `protobuf:"varint,3,opt,name=From,proto3" json:"From,omitempty"`
+	PID             int32         `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
+	Size            int32         `protobuf:"varint,2,opt,name=Size,proto3" json:"Size,omitempty"`
+	From            int32         `protobuf:"varint,3,opt,name=From,proto3" json:"From,omitempty"`
+	InStock         bool          `protobuf:"varint,4,opt,name=InStock,proto3" json:"InStock,omitempty"`
+	AuthorizedOnly  bool          `protobuf:"varint,5,opt,name=AuthorizedOnly,proto3" json:"AuthorizedOnly,omitempty"`
+	DistributorType int32         `protobuf:"varint,6,opt,name=DistributorType,proto3" json:"DistributorType,omitempty"`
+	DistributorIDs  []int32       `protobuf:"varint,7,rep,packed,name=DistributorIDs,proto3" json:"DistributorIDs,omitempty"`
+	MinStock        int32         `protobuf:"varint,8,opt,name=MinStock,proto3" json:"MinStock,omitempty"`
+	SortType        PriceSortType `protobuf:"varint,9,opt,name=SortType,proto3,enum=messages.PriceSortType" json:"SortType,omitempty"`
+	PriceTier       int32         `protobuf:"varint,10,opt,name=PriceTier,proto3" json:"PriceTier,omitempty"`
+	Currencies      []string      `protobuf:"bytes,11,rep,name=Currencies,proto3" json:"Currencies,omitempty"`
+	TargetCurrency  string        `protobuf:"bytes,12,opt,name=TargetCurrency,proto3" json:"TargetCurrency,omitempty"`
+}
+
+func (x *PriceSearchParam) Reset() {
+	*x = PriceSearchParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_pricesearch_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceSearchParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceSearchParam) ProtoMessage() {}
+
+func (x *PriceSearchParam) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_pricesearch_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceSearchParam.ProtoReflect.Descriptor instead.
+func (*PriceSearchParam) Descriptor() ([]byte, []int) {
+	return file_protos_messages_pricesearch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PriceSearchParam) GetPID() int32 {
+	if x != nil {
+		return x.PID
+	}
+	return 0
+}
+
+func (x *PriceSearchParam) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PriceSearchParam) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *PriceSearchParam) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *PriceSearchParam) GetAuthorizedOnly() bool {
+	if x != nil {
+		return x.AuthorizedOnly
+	}
+	return false
+}
+
+func (x *PriceSearchParam) GetDistributorType() int32 {
+	if x != nil {
+		return x.DistributorType
+	}
+	return 0
+}
+
+func (x *PriceSearchParam) GetDistributorIDs() []int32 {
+	if x != nil {
+		return x.DistributorIDs
+	}
+	return nil
+}
+
+func (x *PriceSearchParam) GetMinStock() int32 {
+	if x != nil {
+		return x.MinStock
+	}
+	return 0
+}
+
+func (x *PriceSearchParam) GetSortType() PriceSortType {
+	if x != nil {
+		return x.SortType
+	}
+	return PriceSortType_PRICE_SORT_FRESHNESS
+}
+
+func (x *PriceSearchParam) GetPriceTier() int32 {
+	if x != nil {
+		return x.PriceTier
+	}
+	return 0
+}
+
+func (x *PriceSearchParam) GetCurrencies() []string {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+func (x *PriceSearchParam) GetTargetCurrency() string {
+	if x != nil {
+		return x.TargetCurrency
+	}
+	return ""
+}
+
+// 单型号下的产品价格返回结果, 指定目标币种时 RateTime 为所用汇率的更新时间
+type SearchPricesResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PID            int32           `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
+	Total          int32           `protobuf:"varint,2,opt,name=Total,proto3" json:"Total,omitempty"`
+	From           int32           `protobuf:"varint,3,opt,name=From,proto3" json:"From,omitempty"`
+	Size           int32           `protobuf:"varint,4,opt,name=Size,proto3" json:"Size,omitempty"`
+	Data           []*ESStockPrice `protobuf:"bytes,5,rep,name=Data,proto3" json:"Data,omitempty"`
+	TargetCurrency string          `protobuf:"bytes,6,opt,name=TargetCurrency,proto3" json:"TargetCurrency,omitempty"`
+	RateTime       string          `protobuf:"bytes,7,opt,name=RateTime,proto3" json:"RateTime,omitempty"`
+}
+
+func (x *SearchPricesResult) Reset() {
+	*x = SearchPricesResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_pricesearch_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchPricesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPricesResult) ProtoMessage() {}
+
+func (x *SearchPricesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_pricesearch_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPricesResult.ProtoReflect.Descriptor instead.
+func (*SearchPricesResult) Descriptor() ([]byte, []int) {
+	return file_protos_messages_pricesearch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchPricesResult) GetPID() int32 {
+	if x != nil {
+		return x.PID
+	}
+	return 0
+}
+
+func (x *SearchPricesResult) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchPricesResult) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *SearchPricesResult) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SearchPricesResult) GetData() []*ESStockPrice {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SearchPricesResult) GetTargetCurrency() string {
+	if x != nil {
+		return x.TargetCurrency
+	}
+	return ""
+}
+
+func (x *SearchPricesResult) GetRateTime() string {
+	if x != nil {
+		return x.RateTime
+	}
+	return ""
+}
+
+// 多产品价格搜索参数, PIDs 最多200个, TopSize 为每个产品返回的价格数量
+type ProductsPriceSearchParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PIDs    []int32 `protobuf:"varint,1,rep,packed,name=PIDs,proto3" json:"PIDs,omitempty"`
+	TopSize int32   `protobuf:"varint,2,opt,name=TopSize,proto3" json:"TopSize,omitempty"`
+}
+
+func (x *ProductsPriceSearchParam) Reset() {
+	*x = ProductsPriceSearchParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_pricesearch_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductsPriceSearchParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductsPriceSearchParam) ProtoMessage() {}
+
+func (x *ProductsPriceSearchParam) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_pricesearch_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductsPriceSearchParam.ProtoReflect.Descriptor instead.
+func (*ProductsPriceSearchParam) Descriptor() ([]byte, []int) {
+	return file_protos_messages_pricesearch_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProductsPriceSearchParam) GetPIDs() []int32 {
+	if x != nil {
+		return x.PIDs
+	}
+	return nil
+}
+
+func (x *ProductsPriceSearchParam) GetTopSize() int32 {
+	if x != nil {
+		return x.TopSize
+	}
+	return 0
+}
+
+// 单个产品的价格
+type ProductPrices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PID   int32           `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
+	Total int32           `protobuf:"varint,2,opt,name=Total,proto3" json:"Total,omitempty"`
+	Data  []*ESStockPrice `protobuf:"bytes,3,rep,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (x *ProductPrices) Reset() {
+	*x = ProductPrices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_pricesearch_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductPrices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPrices) ProtoMessage() {}
+
+func (x *ProductPrices) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_pricesearch_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPrices.ProtoReflect.Descriptor instead.
+func (*ProductPrices) Descriptor() ([]byte, []int) {
+	return file_protos_messages_pricesearch_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProductPrices) GetPID() int32 {
+	if x != nil {
+		return x.PID
+	}
+	return 0
+}
+
+func (x *ProductPrices) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ProductPrices) GetData() []*ESStockPrice {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 多产品价格搜索返回结果, Total 为有价格的产品数量
+type SearchPricesByProductsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total   int32            `protobuf:"varint,1,opt,name=Total,proto3" json:"Total,omitempty"`
+	TopSize int32            `protobuf:"varint,2,opt,name=TopSize,proto3" json:"TopSize,omitempty"`
+	Data    []*ProductPrices `protobuf:"bytes,3,rep,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (x *SearchPricesByProductsResult) Reset() {
+	*x = SearchPricesByProductsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_pricesearch_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchPricesByProductsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPricesByProductsResult) ProtoMessage() {}
+
+func (x *SearchPricesByProductsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_pricesearch_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPricesByProductsResult.ProtoReflect.Descriptor instead.
+func (*SearchPricesByProductsResult) Descriptor() ([]byte, []int) {
+	return file_protos_messages_pricesearch_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchPricesByProductsResult) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchPricesByProductsResult) GetTopSize() int32 {
+	if x != nil {
+		return x.TopSize
+	}
+	return 0
+}
+
+func (x *SearchPricesByProductsResult) GetData() []*ProductPrices {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 价格导出参数, ChunkSize 为每批数量, ResumeToken 为中断后续传的令牌
+type ExportPricesParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BrandID       int32  `protobuf:"varint,1,opt,name=BrandID,proto3" json:"BrandID,omitempty"`
+	DistributorID int32  `protobuf:"varint,2,opt,name=DistributorID,proto3" json:"DistributorID,omitempty"`
+	ChunkSize     int32  `protobuf:"varint,3,opt,name=ChunkSize,proto3" json:"ChunkSize,omitempty"`
+	ResumeToken   string `protobuf:"bytes,4,opt,name=ResumeToken,proto3" json:"ResumeToken,omitempty"`
+}
+
+func (x *ExportPricesParam) Reset() {
+	*x = ExportPricesParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_pricesearch_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPricesParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPricesParam) ProtoMessage() {}
+
+func (x *ExportPricesParam) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_pricesearch_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPricesParam.ProtoReflect.Descriptor instead.
+func (*ExportPricesParam) Descriptor() ([]byte, []int) {
+	return file_protos_messages_pricesearch_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExportPricesParam) GetBrandID() int32 {
+	if x != nil {
+		return x.BrandID
+	}
+	return 0
+}
+
+func (x *ExportPricesParam) GetDistributorID() int32 {
+	if x != nil {
+		return x.DistributorID
+	}
+	return 0
+}
+
+func (x *ExportPricesParam) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *ExportPricesParam) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// 价格导出批次, ResumeToken 为本批最后一条的续传令牌
+type ExportPricesChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total       int32           `protobuf:"varint,1,opt,name=Total,proto3" json:"Total,omitempty"`
+	Data        []*ESStockPrice `protobuf:"bytes,2,rep,name=Data,proto3" json:"Data,omitempty"`
+	ResumeToken string          `protobuf:"bytes,3,opt,name=ResumeToken,proto3" json:"ResumeToken,omitempty"`
+}
+
+func (x *ExportPricesChunk) Reset() {
+	*x = ExportPricesChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_pricesearch_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportPricesChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPricesChunk) ProtoMessage() {}
+
+func (x *ExportPricesChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_pricesearch_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPricesChunk.ProtoReflect.Descriptor instead.
+func (*ExportPricesChunk) Descriptor() ([]byte, []int) {
+	return file_protos_messages_pricesearch_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExportPricesChunk) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ExportPricesChunk) GetData() []*ESStockPrice {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportPricesChunk) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// 产品价格
+type ESStockPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SID                string  `protobuf:"bytes,1,opt,name=SID,proto3" json:"SID,omitempty"`
+	ProductName        string  `protobuf:"bytes,2,opt,name=ProductName,proto3" json:"ProductName,omitempty"`
+	DistributorType    int32   `protobuf:"varint,5,opt,name=DistributorType,proto3" json:"DistributorType,omitempty"`
+	DistributorID      int32   `protobuf:"varint,6,opt,name=DistributorID,proto3" json:"DistributorID,omitempty"`
+	Distributor        string  `protobuf:"bytes,7,opt,name=Distributor,proto3" json:"Distributor,omitempty"`
+	StockNum           int32   `protobuf:"varint,8,opt,name=StockNum,proto3" json:"StockNum,omitempty"`
+	Currency           string  `protobuf:"bytes,9,opt,name=Currency,proto3" json:"Currency,omitempty"`
+	Price1             float32 `protobuf:"fixed32,10,opt,name=Price1,proto3" json:"Price1,omitempty"`
+	Price2             float32 `protobuf:"fixed32,11,opt,name=Price2,proto3" json:"Price2,omitempty"`
+	Price3             float32 `protobuf:"fixed32,12,opt,name=Price3,proto3" json:"Price3,omitempty"`
+	Price4             float32 `protobuf:"fixed32,13,opt,name=Price4,proto3" json:"Price4,omitempty"`
+	Price5             float32 `protobuf:"fixed32,14,opt,name=Price5,proto3" json:"Price5,omitempty"`
+	UpdatedUtc         string  `protobuf:"bytes,15,opt,name=UpdatedUtc,proto3" json:"UpdatedUtc,omitempty"`
+	IsAuthorizeddealer bool    `protobuf:"varint,16,opt,name=IsAuthorizeddealer,proto3" json:"IsAuthorizeddealer,omitempty"`
+	// 换算为目标币种后的价格, 十进制字符串
+	ConvertedPrice1 string `protobuf:"bytes,17,opt,name=ConvertedPrice1,proto3" json:"ConvertedPrice1,omitempty"`
+	ConvertedPrice2 string `protobuf:"bytes,18,opt,name=ConvertedPrice2,proto3" json:"ConvertedPrice2,omitempty"`
+	ConvertedPrice3 string `protobuf:"bytes,19,opt,name=ConvertedPrice3,proto3" json:"ConvertedPrice3,omitempty"`
+	ConvertedPrice4 string `protobuf:"bytes,20,opt,name=ConvertedPrice4,proto3" json:"ConvertedPrice4,omitempty"`
+	ConvertedPrice5 string `protobuf:"bytes,21,opt,name=ConvertedPrice5,proto3" json:"ConvertedPrice5,omitempty"`
+	// 最小起订量, 订购倍数及原始阶梯价格
+	MOQ         int32         `protobuf:"varint,22,opt,name=MOQ,proto3" json:"MOQ,omitempty"`
+	Multiples   int32         `protobuf:"varint,23,opt,name=Multiples,proto3" json:"Multiples,omitempty"`
+	PriceBreaks []*PriceBreak `protobuf:"bytes,24,rep,name=PriceBreaks,proto3" json:"PriceBreaks,omitempty"`
+}
+
+func (x *ESStockPrice) Reset() {
+	*x = ESStockPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_pricesearch_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ESStockPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ESStockPrice) ProtoMessage() {}
+
+func (x *ESStockPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_pricesearch_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ESStockPrice.ProtoReflect.Descriptor instead.
+func (*ESStockPrice) Descriptor() ([]byte, []int) {
+	return file_protos_messages_pricesearch_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ESStockPrice) GetSID() string {
+	if x != nil {
+		return x.SID
+	}
+	return ""
+}
+
+func (x *ESStockPrice) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ESStockPrice) GetDistributorType() int32 {
+	if x != nil {
+		return x.DistributorType
+	}
+	return 0
+}
+
+func (x *ESStockPrice) GetDistributorID() int32 {
+	if x != nil {
+		return x.DistributorID
+	}
+	return 0
+}
+
+func (x *ESStockPrice) GetDistributor() string {
+	if x != nil {
+		return x.Distributor
+	}
+	return ""
+}
+
+func (x *ESStockPrice) GetStockNum() int32 {
+	if x != nil {
+		return x.StockNum
+	}
+	return 0
+}
+
+func (x *ESStockPrice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ESStockPrice) GetPrice1() float32 {
+	if x != nil {
+		return x.Price1
+	}
+	return 0
+}
+
+func (x *ESStockPrice) GetPrice2() float32 {
+	if x != nil {
+		return x.Price2
+	}
+	return 0
+}
+
+func (x *ESStockPrice) GetPrice3() float32 {
+	if x != nil {
+		return x.Price3
+	}
+	return 0
+}
+
+func (x *ESStockPrice) GetPrice4() float32 {
+	if x != nil {
+		return x.Price4
+	}
+	return 0
+}
+
+func (x *ESStockPrice) GetPrice5() float32 {
+	if x != nil {
+		return x.Price5
+	}
+	return 0
+}
+
+func (x *ESStockPrice) GetUpdatedUtc() string {
+	if x != nil {
+		return x.UpdatedUtc
+	}
+	return ""
+}
+
+func (x *ESStockPrice) GetIsAuthorizeddealer() bool {
+	if x != nil {
+		return x.IsAuthorizeddealer
+	}
+	return false
+}
+
+func (x *ESStockPrice) GetConvertedPrice1() string {
+	if x != nil {
+		return x.ConvertedPrice1
+	}
+	return ""
+}
+
+func (x *ESStockPrice) GetConvertedPrice2() string {
+	if x != nil {
+		return x.ConvertedPrice2
+	}
+	return ""
+}
+
+func (x *ESStockPrice) GetConvertedPrice3() string {
+	if x != nil {
+		return x.ConvertedPrice3
+	}
+	return ""
+}
+
+func (x *ESStockPrice) GetConvertedPrice4() string {
+	if x != nil {
+		return x.ConvertedPrice4
+	}
+	return ""
+}
+
+func (x *ESStockPrice) GetConvertedPrice5() string {
+	if x != nil {
+		return x.ConvertedPrice5
+	}
+	return ""
+}
+
+func (x *ESStockPrice) GetMOQ() int32 {
+	if x != nil {
+		return x.MOQ
+	}
+	return 0
+}
+
+func (x *ESStockPrice) GetMultiples() int32 {
+	if x != nil {
+		return x.Multiples
+	}
+	return 0
+}
+
+func (x *ESStockPrice) GetPriceBreaks() []*PriceBreak {
+	if x != nil {
+		return x.PriceBreaks
+	}
+	return nil
+}
+
+// 阶梯价格, 数量达到 Qty 时的单价
+type PriceBreak struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Qty   int32  `protobuf:"varint,1,opt,name=Qty,proto3" json:"Qty,omitempty"`
+	Price string `protobuf:"bytes,2,opt,name=Price,proto3" json:"Price,omitempty"`
 }
 
-func (x *PriceSearchParam) Reset() {
-	*x = PriceSearchParam{}
+func (x *PriceBreak) Reset() {
+	*x = PriceBreak{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_messages_pricesearch_proto_msgTypes[0]
+		mi := &file_protos_messages_pricesearch_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PriceSearchParam) String() string {
+func (x *PriceBreak) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PriceSearchParam) ProtoMessage() {}
+func (*PriceBreak) ProtoMessage() {}
 
-func (x *PriceSearchParam) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_messages_pricesearch_proto_msgTypes[0]
+func (x *PriceBreak) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_pricesearch_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,62 +881,58 @@ func (x *PriceSearchParam) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PriceSearchParam.ProtoReflect.Descriptor instead.
-func (*PriceSearchParam) Descriptor() ([]byte, []int) {
-	return file_protos_messages_pricesearch_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *PriceSearchParam) GetPID() int32 {
-	if x != nil {
-		return x.PID
-	}
-	return 0
+// Deprecated: Use PriceBreak.ProtoReflect.Descriptor instead.
+func (*PriceBreak) Descriptor() ([]byte, []int) {
+	return file_protos_messages_pricesearch_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PriceSearchParam) GetSize() int32 {
+func (x *PriceBreak) GetQty() int32 {
 	if x != nil {
-		return x.Size
+		return x.Qty
 	}
 	return 0
 }
 
-func (x *PriceSearchParam) GetFrom() int32 {
+func (x *PriceBreak) GetPrice() string {
 	if x != nil {
-		return x.From
+		return x.Price
 	}
-	return 0
+	return ""
 }
 
-// 单型号下的产品价格返回结果
-type SearchPricesResult struct {
+// 按数量报价参数, 过滤条件与价格搜索一致
+type QuoteParam struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PID   int32           `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
-	Total int32           `protobuf:"varint,2,opt,name=Total,proto3" json:"Total,omitempty"`
-	From  int32           `protobuf:"varint,3,opt,name=From,proto3" json:"From,omitempty"`
-	Size  int32           `protobuf:"varint,4,opt,name=Size,proto3" json:"Size,omitempty"`
-	Data  []*ESStockPrice `protobuf:"bytes,5,rep,name=Data,proto3" json:"Data,omitempty"`
+	PID             int32    `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
+	Quantity        int32    `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	InStock         bool     `protobuf:"varint,3,opt,name=InStock,proto3" json:"InStock,omitempty"`
+	AuthorizedOnly  bool     `protobuf:"varint,4,opt,name=AuthorizedOnly,proto3" json:"AuthorizedOnly,omitempty"`
+	DistributorType int32    `protobuf:"varint,5,opt,name=DistributorType,proto3" json:"DistributorType,omitempty"`
+	DistributorIDs  []int32  `protobuf:"varint,6,rep,packed,name=DistributorIDs,proto3" json:"DistributorIDs,omitempty"`
+	Currencies      []string `protobuf:"bytes,7,rep,name=Currencies,proto3" json:"Currencies,omitempty"`
+	TargetCurrency  string   `protobuf:"bytes,8,opt,name=TargetCurrency,proto3" json:"TargetCurrency,omitempty"`
 }
 
-func (x *SearchPricesResult) Reset() {
-	*x = SearchPricesResult{}
+func (x *QuoteParam) Reset() {
+	*x = QuoteParam{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_messages_pricesearch_proto_msgTypes[1]
+		mi := &file_protos_messages_pricesearch_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchPricesResult) String() string {
+func (x *QuoteParam) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchPricesResult) ProtoMessage() {}
+func (*QuoteParam) ProtoMessage() {}
 
-func (x *SearchPricesResult) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_messages_pricesearch_proto_msgTypes[1]
+func (x *QuoteParam) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_pricesearch_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -124,85 +943,100 @@ func (x *SearchPricesResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchPricesResult.ProtoReflect.Descriptor instead.
-func (*SearchPricesResult) Descriptor() ([]byte, []int) {
-	return file_protos_messages_pricesearch_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QuoteParam.ProtoReflect.Descriptor instead.
+func (*QuoteParam) Descriptor() ([]byte, []int) {
+	return file_protos_messages_pricesearch_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SearchPricesResult) GetPID() int32 {
+func (x *QuoteParam) GetPID() int32 {
 	if x != nil {
 		return x.PID
 	}
 	return 0
 }
 
-func (x *SearchPricesResult) GetTotal() int32 {
+func (x *QuoteParam) GetQuantity() int32 {
 	if x != nil {
-		return x.Total
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *SearchPricesResult) GetFrom() int32 {
+func (x *QuoteParam) GetInStock() bool {
 	if x != nil {
-		return x.From
+		return x.InStock
 	}
-	return 0
+	return false
 }
 
-func (x *SearchPricesResult) GetSize() int32 {
+func (x *QuoteParam) GetAuthorizedOnly() bool {
 	if x != nil {
-		return x.Size
+		return x.AuthorizedOnly
+	}
+	return false
+}
+
+func (x *QuoteParam) GetDistributorType() int32 {
+	if x != nil {
+		return x.DistributorType
 	}
 	return 0
 }
 
-func (x *SearchPricesResult) GetData() []*ESStockPrice {
+func (x *QuoteParam) GetDistributorIDs() []int32 {
 	if x != nil {
-		return x.Data
+		return x.DistributorIDs
 	}
 	return nil
 }
 
-// 产品价格
-type ESStockPrice struct {
+func (x *QuoteParam) GetCurrencies() []string {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+func (x *QuoteParam) GetTargetCurrency() string {
+	if x != nil {
+		return x.TargetCurrency
+	}
+	return ""
+}
+
+// 单个报价, OrderQty 为满足起订量及倍数后的订购数量, BreakQty 为所用阶梯
+type PriceQuote struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SID                string  `protobuf:"bytes,1,opt,name=SID,proto3" json:"SID,omitempty"`
-	ProductName        string  `protobuf:"bytes,2,opt,name=ProductName,proto3" json:"ProductName,omitempty"`
-	DistributorType    int32   `protobuf:"varint,5,opt,name=DistributorType,proto3" json:"DistributorType,omitempty"`
-	DistributorID      int32   `protobuf:"varint,6,opt,name=DistributorID,proto3" json:"DistributorID,omitempty"`
-	Distributor        string  `protobuf:"bytes,7,opt,name=Distributor,proto3" json:"Distributor,omitempty"`
-	StockNum           int32   `protobuf:"varint,8,opt,name=StockNum,proto3" json:"StockNum,omitempty"`
-	Currency           string  `protobuf:"bytes,9,opt,name=Currency,proto3" json:"Currency,omitempty"`
-	Price1             float32 `protobuf:"fixed32,10,opt,name=Price1,proto3" json:"Price1,omitempty"`
-	Price2             float32 `protobuf:"fixed32,11,opt,name=Price2,proto3" json:"Price2,omitempty"`
-	Price3             float32 `protobuf:"fixed32,12,opt,name=Price3,proto3" json:"Price3,omitempty"`
-	Price4             float32 `protobuf:"fixed32,13,opt,name=Price4,proto3" json:"Price4,omitempty"`
-	Price5             float32 `protobuf:"fixed32,14,opt,name=Price5,proto3" json:"Price5,omitempty"`
-	UpdatedUtc         string  `protobuf:"bytes,15,opt,name=UpdatedUtc,proto3" json:"UpdatedUtc,omitempty"`
-	IsAuthorizeddealer bool    `protobuf:"varint,16,opt,name=IsAuthorizeddealer,proto3" json:"IsAuthorizeddealer,omitempty"`
+	Price                  *ESStockPrice `protobuf:"bytes,1,opt,name=Price,proto3" json:"Price,omitempty"`
+	OrderQty               int32         `protobuf:"varint,2,opt,name=OrderQty,proto3" json:"OrderQty,omitempty"`
+	BreakQty               int32         `protobuf:"varint,3,opt,name=BreakQty,proto3" json:"BreakQty,omitempty"`
+	UnitPrice              string        `protobuf:"bytes,4,opt,name=UnitPrice,proto3" json:"UnitPrice,omitempty"`
+	ExtendedPrice          string        `protobuf:"bytes,5,opt,name=ExtendedPrice,proto3" json:"ExtendedPrice,omitempty"`
+	ConvertedUnitPrice     string        `protobuf:"bytes,6,opt,name=ConvertedUnitPrice,proto3" json:"ConvertedUnitPrice,omitempty"`
+	ConvertedExtendedPrice string        `protobuf:"bytes,7,opt,name=ConvertedExtendedPrice,proto3" json:"ConvertedExtendedPrice,omitempty"`
+	StockEnough            bool          `protobuf:"varint,8,opt,name=StockEnough,proto3" json:"StockEnough,omitempty"`
 }
 
-func (x *ESStockPrice) Reset() {
-	*x = ESStockPrice{}
+func (x *PriceQuote) Reset() {
+	*x = PriceQuote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_messages_pricesearch_proto_msgTypes[2]
+		mi := &file_protos_messages_pricesearch_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ESStockPrice) String() string {
+func (x *PriceQuote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ESStockPrice) ProtoMessage() {}
+func (*PriceQuote) ProtoMessage() {}
 
-func (x *ESStockPrice) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_messages_pricesearch_proto_msgTypes[2]
+func (x *PriceQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_pricesearch_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -213,107 +1047,145 @@ func (x *ESStockPrice) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ESStockPrice.ProtoReflect.Descriptor instead.
-func (*ESStockPrice) Descriptor() ([]byte, []int) {
-	return file_protos_messages_pricesearch_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use PriceQuote.ProtoReflect.Descriptor instead.
+func (*PriceQuote) Descriptor() ([]byte, []int) {
+	return file_protos_messages_pricesearch_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ESStockPrice) GetSID() string {
+func (x *PriceQuote) GetPrice() *ESStockPrice {
 	if x != nil {
-		return x.SID
+		return x.Price
 	}
-	return ""
+	return nil
 }
 
-func (x *ESStockPrice) GetProductName() string {
+func (x *PriceQuote) GetOrderQty() int32 {
 	if x != nil {
-		return x.ProductName
+		return x.OrderQty
 	}
-	return ""
+	return 0
 }
 
-func (x *ESStockPrice) GetDistributorType() int32 {
+func (x *PriceQuote) GetBreakQty() int32 {
 	if x != nil {
-		return x.DistributorType
+		return x.BreakQty
 	}
 	return 0
 }
 
-func (x *ESStockPrice) GetDistributorID() int32 {
+func (x *PriceQuote) GetUnitPrice() string {
 	if x != nil {
-		return x.DistributorID
+		return x.UnitPrice
 	}
-	return 0
+	return ""
 }
 
-func (x *ESStockPrice) GetDistributor() string {
+func (x *PriceQuote) GetExtendedPrice() string {
 	if x != nil {
-		return x.Distributor
+		return x.ExtendedPrice
 	}
 	return ""
 }
 
-func (x *ESStockPrice) GetStockNum() int32 {
+func (x *PriceQuote) GetConvertedUnitPrice() string {
 	if x != nil {
-		return x.StockNum
+		return x.ConvertedUnitPrice
 	}
-	return 0
+	return ""
 }
 
-func (x *ESStockPrice) GetCurrency() string {
+func (x *PriceQuote) GetConvertedExtendedPrice() string {
 	if x != nil {
-		return x.Currency
+		return x.ConvertedExtendedPrice
 	}
 	return ""
 }
 
-func (x *ESStockPrice) GetPrice1() float32 {
+func (x *PriceQuote) GetStockEnough() bool {
 	if x != nil {
-		return x.Price1
+		return x.StockEnough
 	}
-	return 0
+	return false
 }
 
-func (x *ESStockPrice) GetPrice2() float32 {
-	if x != nil {
-		return x.Price2
+// 按数量报价结果, 按总价由低到高排序
+type QuotePricesResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PID            int32         `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
+	Quantity       int32         `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	TargetCurrency string        `protobuf:"bytes,3,opt,name=TargetCurrency,proto3" json:"TargetCurrency,omitempty"`
+	RateTime       string        `protobuf:"bytes,4,opt,name=RateTime,proto3" json:"RateTime,omitempty"`
+	Data           []*PriceQuote `protobuf:"bytes,5,rep,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (x *QuotePricesResult) Reset() {
+	*x = QuotePricesResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_pricesearch_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ESStockPrice) GetPrice3() float32 {
+func (x *QuotePricesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePricesResult) ProtoMessage() {}
+
+func (x *QuotePricesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_pricesearch_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotePricesResult.ProtoReflect.Descriptor instead.
+func (*QuotePricesResult) Descriptor() ([]byte, []int) {
+	return file_protos_messages_pricesearch_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QuotePricesResult) GetPID() int32 {
 	if x != nil {
-		return x.Price3
+		return x.PID
 	}
 	return 0
 }
 
-func (x *ESStockPrice) GetPrice4() float32 {
+func (x *QuotePricesResult) GetQuantity() int32 {
 	if x != nil {
-		return x.Price4
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *ESStockPrice) GetPrice5() float32 {
+func (x *QuotePricesResult) GetTargetCurrency() string {
 	if x != nil {
-		return x.Price5
+		return x.TargetCurrency
 	}
-	return 0
+	return ""
 }
 
-func (x *ESStockPrice) GetUpdatedUtc() string {
+func (x *QuotePricesResult) GetRateTime() string {
 	if x != nil {
-		return x.UpdatedUtc
+		return x.RateTime
 	}
 	return ""
 }
 
-func (x *ESStockPrice) GetIsAuthorizeddealer() bool {
+func (x *QuotePricesResult) GetData() []*PriceQuote {
 	if x != nil {
-		return x.IsAuthorizeddealer
+		return x.Data
 	}
-	return false
+	return nil
 }
 
 var File_protos_messages_pricesearch_proto protoreflect.FileDescriptor
@@ -321,53 +1193,192 @@ var File_protos_messages_pricesearch_proto protoreflect.FileDescriptor
 var file_protos_messages_pricesearch_proto_rawDesc = []byte{
 	0x0a, 0x21, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x73, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x4c, 0x0a,
-	0x10, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
-	0x50, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x90, 0x01, 0x0a, 0x12,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75,
+	0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x97, 0x03,
+	0x0a, 0x10, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x50, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07,
+	0x49, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49,
+	0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x28,
+	0x0a, 0x0f, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x0e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x33, 0x0a, 0x08,
+	0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53,
+	0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x65, 0x72, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x65, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0x26, 0x0a, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xd4, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2a,
+	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45, 0x53, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x48,
+	0x0a, 0x18, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x49,
+	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x50, 0x49, 0x44, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x54, 0x6f, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x54, 0x6f, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x63, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x2a, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45, 0x53, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x7b, 0x0a,
+	0x1c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x42, 0x79, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x6f, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x54, 0x6f, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2b, 0x0a,
+	0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x93, 0x01, 0x0a, 0x11, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x12, 0x18, 0x0a, 0x07, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x44,
+	0x12, 0x1c, 0x0a, 0x09, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x77, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x04, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x45, 0x53, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xee, 0x05, 0x0a, 0x0c, 0x45, 0x53,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x53, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28,
+	0x0a, 0x0f, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x20,
+	0x0a, 0x0b, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x31,
+	0x12, 0x16, 0x0a, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x32, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x33, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x33,
+	0x12, 0x16, 0x0a, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x34, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x34, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x35, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x35,
+	0x12, 0x1e, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x55, 0x74, 0x63, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x55, 0x74, 0x63,
+	0x12, 0x2e, 0x0a, 0x12, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64,
+	0x64, 0x65, 0x61, 0x6c, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x49, 0x73,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x64, 0x65, 0x61, 0x6c, 0x65, 0x72,
+	0x12, 0x28, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x31, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x32, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x32, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65,
+	0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x33, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x33, 0x12, 0x28,
+	0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x34, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x34, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x35, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x35, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x4f, 0x51, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x4d, 0x4f, 0x51, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65,
+	0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x65, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x0b, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x73, 0x22, 0x34, 0x0a, 0x0a, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x51, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x51, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x22, 0x96, 0x02, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x49, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x49, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12,
+	0x28, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x44, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x0e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x44,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xc0, 0x02, 0x0a, 0x0a, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x45, 0x53, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x51,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x51,
+	0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x51, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x51, 0x74, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x55, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x55, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x55,
+	0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x16, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x6f, 0x75, 0x67, 0x68, 0x22, 0xaf, 0x01, 0x0a,
+	0x11, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75,
 	0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x03, 0x50, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72,
-	0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x12,
-	0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45, 0x53, 0x53, 0x74,
-	0x6f, 0x63, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0xb4,
-	0x03, 0x0a, 0x0c, 0x45, 0x53, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x53, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x53, 0x49,
-	0x44, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x44, 0x69,
-	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a,
-	0x0d, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f,
-	0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
-	0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
-	0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a,
-	0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x31, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x32, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x32, 0x12, 0x16, 0x0a,
-	0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x33, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x33, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x34, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x34, 0x12, 0x16, 0x0a,
-	0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x35, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x35, 0x12, 0x1e, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x55, 0x74, 0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x55, 0x74, 0x63, 0x12, 0x2e, 0x0a, 0x12, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x65, 0x64, 0x64, 0x65, 0x61, 0x6c, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x12, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x64,
-	0x65, 0x61, 0x6c, 0x65, 0x72, 0x42, 0x37, 0x5a, 0x19, 0x65, 0x61, 0x73, 0x79, 0x6d, 0x73, 0x2d,
-	0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0xaa, 0x02, 0x19, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x03, 0x50, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x26, 0x0a, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x2a, 0x64,
+	0x0a, 0x0d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x14, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x52,
+	0x45, 0x53, 0x48, 0x4e, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x49,
+	0x43, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4c, 0x4f, 0x57, 0x45, 0x53, 0x54, 0x5f, 0x50,
+	0x52, 0x49, 0x43, 0x45, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f,
+	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x4f,
+	0x43, 0x4b, 0x10, 0x02, 0x42, 0x37, 0x5a, 0x19, 0x65, 0x61, 0x73, 0x79, 0x6d, 0x73, 0x2d, 0x65,
+	0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0xaa, 0x02, 0x19, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -382,19 +1393,37 @@ func file_protos_messages_pricesearch_proto_rawDescGZIP() []byte {
 	return file_protos_messages_pricesearch_proto_rawDescData
 }
 
-var file_protos_messages_pricesearch_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_protos_messages_pricesearch_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_protos_messages_pricesearch_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_protos_messages_pricesearch_proto_goTypes = []any{
-	(*PriceSearchParam)(nil),   // 0: messages.PriceSearchParam
-	(*SearchPricesResult)(nil), // 1: messages.SearchPricesResult
-	(*ESStockPrice)(nil),       // 2: messages.ESStockPrice
+	(PriceSortType)(0),                   // 0: messages.PriceSortType
+	(*PriceSearchParam)(nil),             // 1: messages.PriceSearchParam
+	(*SearchPricesResult)(nil),           // 2: messages.SearchPricesResult
+	(*ProductsPriceSearchParam)(nil),     // 3: messages.ProductsPriceSearchParam
+	(*ProductPrices)(nil),                // 4: messages.ProductPrices
+	(*SearchPricesByProductsResult)(nil), // 5: messages.SearchPricesByProductsResult
+	(*ExportPricesParam)(nil),            // 6: messages.ExportPricesParam
+	(*ExportPricesChunk)(nil),            // 7: messages.ExportPricesChunk
+	(*ESStockPrice)(nil),                 // 8: messages.ESStockPrice
+	(*PriceBreak)(nil),                   // 9: messages.PriceBreak
+	(*QuoteParam)(nil),                   // 10: messages.QuoteParam
+	(*PriceQuote)(nil),                   // 11: messages.PriceQuote
+	(*QuotePricesResult)(nil),            // 12: messages.QuotePricesResult
 }
 var file_protos_messages_pricesearch_proto_depIdxs = []int32{
-	2, // 0: messages.SearchPricesResult.Data:type_name -> messages.ESStockPrice
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: messages.PriceSearchParam.SortType:type_name -> messages.PriceSortType
+	8,  // 1: messages.SearchPricesResult.Data:type_name -> messages.ESStockPrice
+	8,  // 2: messages.ProductPrices.Data:type_name -> messages.ESStockPrice
+	4,  // 3: messages.SearchPricesByProductsResult.Data:type_name -> messages.ProductPrices
+	8,  // 4: messages.ExportPricesChunk.Data:type_name -> messages.ESStockPrice
+	9,  // 5: messages.ESStockPrice.PriceBreaks:type_name -> messages.PriceBreak
+	8,  // 6: messages.PriceQuote.Price:type_name -> messages.ESStockPrice
+	11, // 7: messages.QuotePricesResult.Data:type_name -> messages.PriceQuote
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_protos_messages_pricesearch_proto_init() }
@@ -428,6 +1457,66 @@ func file_protos_messages_pricesearch_proto_init() {
 			}
 		}
 		file_protos_messages_pricesearch_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductsPriceSearchParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_pricesearch_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductPrices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_pricesearch_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchPricesByProductsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_pricesearch_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportPricesParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_pricesearch_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportPricesChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_pricesearch_proto_msgTypes[7].Exporter = func(v any, i int) any {
 			switch v := v.(*ESStockPrice); i {
 			case 0:
 				return &v.state
@@ -439,19 +1528,68 @@ func file_protos_messages_pricesearch_proto_init() {
 				return nil
 			}
 		}
+		file_protos_messages_pricesearch_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceBreak); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_pricesearch_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*QuoteParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_pricesearch_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_pricesearch_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*QuotePricesResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_messages_pricesearch_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_protos_messages_pricesearch_proto_goTypes,
 		DependencyIndexes: file_protos_messages_pricesearch_proto_depIdxs,
+		EnumInfos:         file_protos_messages_pricesearch_proto_enumTypes,
 		MessageInfos:      file_protos_messages_pricesearch_proto_msgTypes,
 	}.Build()
 	File_protos_messages_pricesearch_proto = out.File
@@ -26,7 +26,19 @@ type ProductSearchParam struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KeyWord string `protobuf:"bytes,1,opt,name=KeyWord,proto3" json:"KeyWord,omitempty"`
+	KeyWord        string       `protobuf:"bytes,1,opt,name=KeyWord,proto3" json:"KeyWord,omitempty"`
+	ProductName    string       `protobuf:"bytes,2,opt,name=ProductName,proto3" json:"ProductName,omitempty"`
+	Brand          string       `protobuf:"bytes,3,opt,name=Brand,proto3" json:"Brand,omitempty"`
+	Category       string       `protobuf:"bytes,4,opt,name=Category,proto3" json:"Category,omitempty"`
+	PassiveParam   string       `protobuf:"bytes,5,opt,name=PassiveParam,proto3" json:"PassiveParam,omitempty"`
+	ParentIDs      []int32      `protobuf:"varint,6,rep,packed,name=ParentIDs,proto3" json:"ParentIDs,omitempty"`
+	CategoryIDs    []int32      `protobuf:"varint,7,rep,packed,name=CategoryIDs,proto3" json:"CategoryIDs,omitempty"`
+	BrandIDs       []int32      `protobuf:"varint,8,rep,packed,name=BrandIDs,proto3" json:"BrandIDs,omitempty"`
+	DistributorIDs []int32      `protobuf:"varint,9,rep,packed,name=DistributorIDs,proto3" json:"DistributorIDs,omitempty"`
+	Attributes     []*Attribute `protobuf:"bytes,10,rep,name=Attributes,proto3" json:"Attributes,omitempty"`
+	IsHighlight    bool         `protobuf:"varint,11,opt,name=IsHighlight,proto3" json:"IsHighlight,omitempty"`
+	Size           int32        `protobuf:"varint,12,opt,name=Size,proto3" json:"Size,omitempty"`
+	From           int32        `protobuf:"varint,13,opt,name=From,proto3" json:"From,omitempty"`
 }
 
 func (x *ProductSearchParam) Reset() {
@@ -68,6 +80,1490 @@ func (x *ProductSearchParam) GetKeyWord() string {
 	return ""
 }
 
+func (x *ProductSearchParam) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ProductSearchParam) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *ProductSearchParam) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ProductSearchParam) GetPassiveParam() string {
+	if x != nil {
+		return x.PassiveParam
+	}
+	return ""
+}
+
+func (x *ProductSearchParam) GetParentIDs() []int32 {
+	if x != nil {
+		return x.ParentIDs
+	}
+	return nil
+}
+
+func (x *ProductSearchParam) GetCategoryIDs() []int32 {
+	if x != nil {
+		return x.CategoryIDs
+	}
+	return nil
+}
+
+func (x *ProductSearchParam) GetBrandIDs() []int32 {
+	if x != nil {
+		return x.BrandIDs
+	}
+	return nil
+}
+
+func (x *ProductSearchParam) GetDistributorIDs() []int32 {
+	if x != nil {
+		return x.DistributorIDs
+	}
+	return nil
+}
+
+func (x *ProductSearchParam) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *ProductSearchParam) GetIsHighlight() bool {
+	if x != nil {
+		return x.IsHighlight
+	}
+	return false
+}
+
+func (x *ProductSearchParam) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ProductSearchParam) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+// 属性筛选条件, 同一属性下的属性值为或关系, 不同属性之间为且关系
+type Attribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttributeName   string   `protobuf:"bytes,1,opt,name=AttributeName,proto3" json:"AttributeName,omitempty"`
+	AttributeValues []string `protobuf:"bytes,2,rep,name=AttributeValues,proto3" json:"AttributeValues,omitempty"`
+}
+
+func (x *Attribute) Reset() {
+	*x = Attribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attribute) ProtoMessage() {}
+
+func (x *Attribute) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attribute.ProtoReflect.Descriptor instead.
+func (*Attribute) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Attribute) GetAttributeName() string {
+	if x != nil {
+		return x.AttributeName
+	}
+	return ""
+}
+
+func (x *Attribute) GetAttributeValues() []string {
+	if x != nil {
+		return x.AttributeValues
+	}
+	return nil
+}
+
+// 产品搜索返回结果
+type SearchProductsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total               int32        `protobuf:"varint,1,opt,name=Total,proto3" json:"Total,omitempty"`
+	Above               bool         `protobuf:"varint,2,opt,name=Above,proto3" json:"Above,omitempty"`
+	From                int32        `protobuf:"varint,3,opt,name=From,proto3" json:"From,omitempty"`
+	Size                int32        `protobuf:"varint,4,opt,name=Size,proto3" json:"Size,omitempty"`
+	IsReSearch          bool         `protobuf:"varint,5,opt,name=IsReSearch,proto3" json:"IsReSearch,omitempty"`
+	SimilarProductNames []string     `protobuf:"bytes,6,rep,name=SimilarProductNames,proto3" json:"SimilarProductNames,omitempty"`
+	Data                []*ESProduct `protobuf:"bytes,7,rep,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (x *SearchProductsResult) Reset() {
+	*x = SearchProductsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchProductsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsResult) ProtoMessage() {}
+
+func (x *SearchProductsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsResult.ProtoReflect.Descriptor instead.
+func (*SearchProductsResult) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchProductsResult) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchProductsResult) GetAbove() bool {
+	if x != nil {
+		return x.Above
+	}
+	return false
+}
+
+func (x *SearchProductsResult) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *SearchProductsResult) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SearchProductsResult) GetIsReSearch() bool {
+	if x != nil {
+		return x.IsReSearch
+	}
+	return false
+}
+
+func (x *SearchProductsResult) GetSimilarProductNames() []string {
+	if x != nil {
+		return x.SimilarProductNames
+	}
+	return nil
+}
+
+func (x *SearchProductsResult) GetData() []*ESProduct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 产品
+type ESProduct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PID                  int32  `protobuf:"varint,1,opt,name=PID,proto3" json:"PID,omitempty"`
+	ProductName          string `protobuf:"bytes,2,opt,name=ProductName,proto3" json:"ProductName,omitempty"`
+	Brand                string `protobuf:"bytes,3,opt,name=Brand,proto3" json:"Brand,omitempty"`
+	BrandID              int32  `protobuf:"varint,4,opt,name=BrandID,proto3" json:"BrandID,omitempty"`
+	ParentID             int32  `protobuf:"varint,5,opt,name=ParentID,proto3" json:"ParentID,omitempty"`
+	ParentCategory       string `protobuf:"bytes,6,opt,name=ParentCategory,proto3" json:"ParentCategory,omitempty"`
+	CategoryID           int32  `protobuf:"varint,7,opt,name=CategoryID,proto3" json:"CategoryID,omitempty"`
+	Category             string `protobuf:"bytes,8,opt,name=Category,proto3" json:"Category,omitempty"`
+	Description          string `protobuf:"bytes,9,opt,name=Description,proto3" json:"Description,omitempty"`
+	PicUrl               string `protobuf:"bytes,10,opt,name=PicUrl,proto3" json:"PicUrl,omitempty"`
+	PdfUrl               string `protobuf:"bytes,11,opt,name=PdfUrl,proto3" json:"PdfUrl,omitempty"`
+	PriceGroup           int32  `protobuf:"varint,12,opt,name=PriceGroup,proto3" json:"PriceGroup,omitempty"`
+	HighlightProductName string `protobuf:"bytes,13,opt,name=HighlightProductName,proto3" json:"HighlightProductName,omitempty"`
+}
+
+func (x *ESProduct) Reset() {
+	*x = ESProduct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ESProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ESProduct) ProtoMessage() {}
+
+func (x *ESProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ESProduct.ProtoReflect.Descriptor instead.
+func (*ESProduct) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ESProduct) GetPID() int32 {
+	if x != nil {
+		return x.PID
+	}
+	return 0
+}
+
+func (x *ESProduct) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ESProduct) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *ESProduct) GetBrandID() int32 {
+	if x != nil {
+		return x.BrandID
+	}
+	return 0
+}
+
+func (x *ESProduct) GetParentID() int32 {
+	if x != nil {
+		return x.ParentID
+	}
+	return 0
+}
+
+func (x *ESProduct) GetParentCategory() string {
+	if x != nil {
+		return x.ParentCategory
+	}
+	return ""
+}
+
+func (x *ESProduct) GetCategoryID() int32 {
+	if x != nil {
+		return x.CategoryID
+	}
+	return 0
+}
+
+func (x *ESProduct) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ESProduct) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ESProduct) GetPicUrl() string {
+	if x != nil {
+		return x.PicUrl
+	}
+	return ""
+}
+
+func (x *ESProduct) GetPdfUrl() string {
+	if x != nil {
+		return x.PdfUrl
+	}
+	return ""
+}
+
+func (x *ESProduct) GetPriceGroup() int32 {
+	if x != nil {
+		return x.PriceGroup
+	}
+	return 0
+}
+
+func (x *ESProduct) GetHighlightProductName() string {
+	if x != nil {
+		return x.HighlightProductName
+	}
+	return ""
+}
+
+// 分面导航搜索参数, 只有分类条件时优先读取缓存
+type FacetSearchParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query *ProductSearchParam `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	Size  int32               `protobuf:"varint,2,opt,name=Size,proto3" json:"Size,omitempty"`
+}
+
+func (x *FacetSearchParam) Reset() {
+	*x = FacetSearchParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FacetSearchParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetSearchParam) ProtoMessage() {}
+
+func (x *FacetSearchParam) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetSearchParam.ProtoReflect.Descriptor instead.
+func (*FacetSearchParam) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FacetSearchParam) GetQuery() *ProductSearchParam {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *FacetSearchParam) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 分面桶, 分类为 父级分类 -> 子分类, 属性值为 属性名 -> 属性值
+type FacetBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string         `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Name     string         `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Group    string         `protobuf:"bytes,3,opt,name=Group,proto3" json:"Group,omitempty"`
+	Count    int32          `protobuf:"varint,4,opt,name=Count,proto3" json:"Count,omitempty"`
+	Children []*FacetBucket `protobuf:"bytes,5,rep,name=Children,proto3" json:"Children,omitempty"`
+}
+
+func (x *FacetBucket) Reset() {
+	*x = FacetBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FacetBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetBucket) ProtoMessage() {}
+
+func (x *FacetBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetBucket.ProtoReflect.Descriptor instead.
+func (*FacetBucket) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FacetBucket) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FacetBucket) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FacetBucket) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *FacetBucket) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *FacetBucket) GetChildren() []*FacetBucket {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+// 分面导航搜索结果
+type FacetsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Categories      []*FacetBucket `protobuf:"bytes,1,rep,name=Categories,proto3" json:"Categories,omitempty"`
+	Brands          []*FacetBucket `protobuf:"bytes,2,rep,name=Brands,proto3" json:"Brands,omitempty"`
+	Distributors    []*FacetBucket `protobuf:"bytes,3,rep,name=Distributors,proto3" json:"Distributors,omitempty"`
+	AttributeNames  []*FacetBucket `protobuf:"bytes,4,rep,name=AttributeNames,proto3" json:"AttributeNames,omitempty"`
+	AttributeValues []*FacetBucket `protobuf:"bytes,5,rep,name=AttributeValues,proto3" json:"AttributeValues,omitempty"`
+	IsCache         bool           `protobuf:"varint,6,opt,name=IsCache,proto3" json:"IsCache,omitempty"`
+}
+
+func (x *FacetsResult) Reset() {
+	*x = FacetsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FacetsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetsResult) ProtoMessage() {}
+
+func (x *FacetsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetsResult.ProtoReflect.Descriptor instead.
+func (*FacetsResult) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FacetsResult) GetCategories() []*FacetBucket {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *FacetsResult) GetBrands() []*FacetBucket {
+	if x != nil {
+		return x.Brands
+	}
+	return nil
+}
+
+func (x *FacetsResult) GetDistributors() []*FacetBucket {
+	if x != nil {
+		return x.Distributors
+	}
+	return nil
+}
+
+func (x *FacetsResult) GetAttributeNames() []*FacetBucket {
+	if x != nil {
+		return x.AttributeNames
+	}
+	return nil
+}
+
+func (x *FacetsResult) GetAttributeValues() []*FacetBucket {
+	if x != nil {
+		return x.AttributeValues
+	}
+	return nil
+}
+
+func (x *FacetsResult) GetIsCache() bool {
+	if x != nil {
+		return x.IsCache
+	}
+	return false
+}
+
+// 型号首字母索引浏览参数, LastPID 为上一页最后一个PID(游标分页)
+type ProductIndexSearchParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductNameIndex int32 `protobuf:"varint,1,opt,name=ProductNameIndex,proto3" json:"ProductNameIndex,omitempty"`
+	IsFollow         bool  `protobuf:"varint,2,opt,name=IsFollow,proto3" json:"IsFollow,omitempty"`
+	ParentID         int32 `protobuf:"varint,3,opt,name=ParentID,proto3" json:"ParentID,omitempty"`
+	CategoryID       int32 `protobuf:"varint,4,opt,name=CategoryID,proto3" json:"CategoryID,omitempty"`
+	Size             int32 `protobuf:"varint,5,opt,name=Size,proto3" json:"Size,omitempty"`
+	LastPID          int32 `protobuf:"varint,6,opt,name=LastPID,proto3" json:"LastPID,omitempty"`
+}
+
+func (x *ProductIndexSearchParam) Reset() {
+	*x = ProductIndexSearchParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductIndexSearchParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductIndexSearchParam) ProtoMessage() {}
+
+func (x *ProductIndexSearchParam) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductIndexSearchParam.ProtoReflect.Descriptor instead.
+func (*ProductIndexSearchParam) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProductIndexSearchParam) GetProductNameIndex() int32 {
+	if x != nil {
+		return x.ProductNameIndex
+	}
+	return 0
+}
+
+func (x *ProductIndexSearchParam) GetIsFollow() bool {
+	if x != nil {
+		return x.IsFollow
+	}
+	return false
+}
+
+func (x *ProductIndexSearchParam) GetParentID() int32 {
+	if x != nil {
+		return x.ParentID
+	}
+	return 0
+}
+
+func (x *ProductIndexSearchParam) GetCategoryID() int32 {
+	if x != nil {
+		return x.CategoryID
+	}
+	return 0
+}
+
+func (x *ProductIndexSearchParam) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ProductIndexSearchParam) GetLastPID() int32 {
+	if x != nil {
+		return x.LastPID
+	}
+	return 0
+}
+
+// 型号首字母索引数量, ProductNameIndex 1-36 对应 0-9A-Z, 37 为特殊字符
+type ProductIndexCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductNameIndex int32  `protobuf:"varint,1,opt,name=ProductNameIndex,proto3" json:"ProductNameIndex,omitempty"`
+	Initial          string `protobuf:"bytes,2,opt,name=Initial,proto3" json:"Initial,omitempty"`
+	Count            int32  `protobuf:"varint,3,opt,name=Count,proto3" json:"Count,omitempty"`
+}
+
+func (x *ProductIndexCount) Reset() {
+	*x = ProductIndexCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductIndexCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductIndexCount) ProtoMessage() {}
+
+func (x *ProductIndexCount) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductIndexCount.ProtoReflect.Descriptor instead.
+func (*ProductIndexCount) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProductIndexCount) GetProductNameIndex() int32 {
+	if x != nil {
+		return x.ProductNameIndex
+	}
+	return 0
+}
+
+func (x *ProductIndexCount) GetInitial() string {
+	if x != nil {
+		return x.Initial
+	}
+	return ""
+}
+
+func (x *ProductIndexCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 型号首字母索引浏览结果, LastPID 为下一页的游标
+type ProductIndexResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total   int32                `protobuf:"varint,1,opt,name=Total,proto3" json:"Total,omitempty"`
+	Size    int32                `protobuf:"varint,2,opt,name=Size,proto3" json:"Size,omitempty"`
+	LastPID int32                `protobuf:"varint,3,opt,name=LastPID,proto3" json:"LastPID,omitempty"`
+	Data    []*ESProduct         `protobuf:"bytes,4,rep,name=Data,proto3" json:"Data,omitempty"`
+	Indexes []*ProductIndexCount `protobuf:"bytes,5,rep,name=Indexes,proto3" json:"Indexes,omitempty"`
+}
+
+func (x *ProductIndexResult) Reset() {
+	*x = ProductIndexResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductIndexResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductIndexResult) ProtoMessage() {}
+
+func (x *ProductIndexResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductIndexResult.ProtoReflect.Descriptor instead.
+func (*ProductIndexResult) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProductIndexResult) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ProductIndexResult) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ProductIndexResult) GetLastPID() int32 {
+	if x != nil {
+		return x.LastPID
+	}
+	return 0
+}
+
+func (x *ProductIndexResult) GetData() []*ESProduct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ProductIndexResult) GetIndexes() []*ProductIndexCount {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+// 自定义搜索的关键词列表
+type KeyWordList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=Values,proto3" json:"Values,omitempty"`
+}
+
+func (x *KeyWordList) Reset() {
+	*x = KeyWordList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyWordList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyWordList) ProtoMessage() {}
+
+func (x *KeyWordList) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyWordList.ProtoReflect.Descriptor instead.
+func (*KeyWordList) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *KeyWordList) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// 自定义搜索的范围条件, 只支持整数及日期字段, Gte、Lte 未设置时不限制(可为0)
+type CustomRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=Field,proto3" json:"Field,omitempty"`
+	Gte   *int32 `protobuf:"varint,2,opt,name=Gte,proto3,oneof" json:"Gte,omitempty"`
+	Lte   *int32 `protobuf:"varint,3,opt,name=Lte,proto3,oneof" json:"Lte,omitempty"`
+}
+
+func (x *CustomRange) Reset() {
+	*x = CustomRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomRange) ProtoMessage() {}
+
+func (x *CustomRange) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomRange.ProtoReflect.Descriptor instead.
+func (*CustomRange) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CustomRange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *CustomRange) GetGte() int32 {
+	if x != nil && x.Gte != nil {
+		return *x.Gte
+	}
+	return 0
+}
+
+func (x *CustomRange) GetLte() int32 {
+	if x != nil && x.Lte != nil {
+		return *x.Lte
+	}
+	return 0
+}
+
+// 自定义产品搜索参数, 字段名为 ES 索引字段名, 需在白名单内
+type CustomSearchProductParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyWords       map[string]*KeyWordList `protobuf:"bytes,1,rep,name=KeyWords,proto3" json:"KeyWords,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Filters        map[string]string       `protobuf:"bytes,2,rep,name=Filters,proto3" json:"Filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TermsFilters   map[string]string       `protobuf:"bytes,3,rep,name=TermsFilters,proto3" json:"TermsFilters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Range          *CustomRange            `protobuf:"bytes,4,opt,name=Range,proto3" json:"Range,omitempty"`
+	AttributeNames []string                `protobuf:"bytes,5,rep,name=AttributeNames,proto3" json:"AttributeNames,omitempty"`
+	Sources        string                  `protobuf:"bytes,6,opt,name=Sources,proto3" json:"Sources,omitempty"`
+	Sorts          map[string]string       `protobuf:"bytes,7,rep,name=Sorts,proto3" json:"Sorts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Boots          []int32                 `protobuf:"varint,8,rep,packed,name=Boots,proto3" json:"Boots,omitempty"`
+	IsHighlight    bool                    `protobuf:"varint,9,opt,name=IsHighlight,proto3" json:"IsHighlight,omitempty"`
+	Size           int32                   `protobuf:"varint,10,opt,name=Size,proto3" json:"Size,omitempty"`
+	From           int32                   `protobuf:"varint,11,opt,name=From,proto3" json:"From,omitempty"`
+}
+
+func (x *CustomSearchProductParam) Reset() {
+	*x = CustomSearchProductParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomSearchProductParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomSearchProductParam) ProtoMessage() {}
+
+func (x *CustomSearchProductParam) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomSearchProductParam.ProtoReflect.Descriptor instead.
+func (*CustomSearchProductParam) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CustomSearchProductParam) GetKeyWords() map[string]*KeyWordList {
+	if x != nil {
+		return x.KeyWords
+	}
+	return nil
+}
+
+func (x *CustomSearchProductParam) GetFilters() map[string]string {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *CustomSearchProductParam) GetTermsFilters() map[string]string {
+	if x != nil {
+		return x.TermsFilters
+	}
+	return nil
+}
+
+func (x *CustomSearchProductParam) GetRange() *CustomRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *CustomSearchProductParam) GetAttributeNames() []string {
+	if x != nil {
+		return x.AttributeNames
+	}
+	return nil
+}
+
+func (x *CustomSearchProductParam) GetSources() string {
+	if x != nil {
+		return x.Sources
+	}
+	return ""
+}
+
+func (x *CustomSearchProductParam) GetSorts() map[string]string {
+	if x != nil {
+		return x.Sorts
+	}
+	return nil
+}
+
+func (x *CustomSearchProductParam) GetBoots() []int32 {
+	if x != nil {
+		return x.Boots
+	}
+	return nil
+}
+
+func (x *CustomSearchProductParam) GetIsHighlight() bool {
+	if x != nil {
+		return x.IsHighlight
+	}
+	return false
+}
+
+func (x *CustomSearchProductParam) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CustomSearchProductParam) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+// 产品导出参数, ChunkSize 为每批数量, ResumeToken 为中断后续传的令牌
+type ExportProductsParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BrandID       int32  `protobuf:"varint,1,opt,name=BrandID,proto3" json:"BrandID,omitempty"`
+	ParentID      int32  `protobuf:"varint,2,opt,name=ParentID,proto3" json:"ParentID,omitempty"`
+	CategoryID    int32  `protobuf:"varint,3,opt,name=CategoryID,proto3" json:"CategoryID,omitempty"`
+	DistributorID int32  `protobuf:"varint,4,opt,name=DistributorID,proto3" json:"DistributorID,omitempty"`
+	ChunkSize     int32  `protobuf:"varint,5,opt,name=ChunkSize,proto3" json:"ChunkSize,omitempty"`
+	ResumeToken   string `protobuf:"bytes,6,opt,name=ResumeToken,proto3" json:"ResumeToken,omitempty"`
+}
+
+func (x *ExportProductsParam) Reset() {
+	*x = ExportProductsParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportProductsParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsParam) ProtoMessage() {}
+
+func (x *ExportProductsParam) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsParam.ProtoReflect.Descriptor instead.
+func (*ExportProductsParam) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportProductsParam) GetBrandID() int32 {
+	if x != nil {
+		return x.BrandID
+	}
+	return 0
+}
+
+func (x *ExportProductsParam) GetParentID() int32 {
+	if x != nil {
+		return x.ParentID
+	}
+	return 0
+}
+
+func (x *ExportProductsParam) GetCategoryID() int32 {
+	if x != nil {
+		return x.CategoryID
+	}
+	return 0
+}
+
+func (x *ExportProductsParam) GetDistributorID() int32 {
+	if x != nil {
+		return x.DistributorID
+	}
+	return 0
+}
+
+func (x *ExportProductsParam) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *ExportProductsParam) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// 产品导出批次, ResumeToken 为本批最后一条的续传令牌
+type ExportProductsChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total       int32        `protobuf:"varint,1,opt,name=Total,proto3" json:"Total,omitempty"`
+	Data        []*ESProduct `protobuf:"bytes,2,rep,name=Data,proto3" json:"Data,omitempty"`
+	ResumeToken string       `protobuf:"bytes,3,opt,name=ResumeToken,proto3" json:"ResumeToken,omitempty"`
+}
+
+func (x *ExportProductsChunk) Reset() {
+	*x = ExportProductsChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportProductsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsChunk) ProtoMessage() {}
+
+func (x *ExportProductsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsChunk.ProtoReflect.Descriptor instead.
+func (*ExportProductsChunk) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportProductsChunk) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ExportProductsChunk) GetData() []*ESProduct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportProductsChunk) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// 异步搜索提交参数, WithFacets 为 true 时同时返回分面聚合
+type SubmitSearchParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query      *ProductSearchParam `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	WithFacets bool                `protobuf:"varint,2,opt,name=WithFacets,proto3" json:"WithFacets,omitempty"`
+	FacetSize  int32               `protobuf:"varint,3,opt,name=FacetSize,proto3" json:"FacetSize,omitempty"`
+}
+
+func (x *SubmitSearchParam) Reset() {
+	*x = SubmitSearchParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitSearchParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSearchParam) ProtoMessage() {}
+
+func (x *SubmitSearchParam) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSearchParam.ProtoReflect.Descriptor instead.
+func (*SubmitSearchParam) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SubmitSearchParam) GetQuery() *ProductSearchParam {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *SubmitSearchParam) GetWithFacets() bool {
+	if x != nil {
+		return x.WithFacets
+	}
+	return false
+}
+
+func (x *SubmitSearchParam) GetFacetSize() int32 {
+	if x != nil {
+		return x.FacetSize
+	}
+	return 0
+}
+
+// 异步搜索任务ID
+type AsyncSearchID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *AsyncSearchID) Reset() {
+	*x = AsyncSearchID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncSearchID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncSearchID) ProtoMessage() {}
+
+func (x *AsyncSearchID) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncSearchID.ProtoReflect.Descriptor instead.
+func (*AsyncSearchID) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AsyncSearchID) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+// 异步搜索状态及结果, 运行中时为部分结果, 时间为毫秒时间戳
+type AsyncSearchStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID             string                `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	IsRunning      bool                  `protobuf:"varint,2,opt,name=IsRunning,proto3" json:"IsRunning,omitempty"`
+	IsPartial      bool                  `protobuf:"varint,3,opt,name=IsPartial,proto3" json:"IsPartial,omitempty"`
+	StartTime      int64                 `protobuf:"varint,4,opt,name=StartTime,proto3" json:"StartTime,omitempty"`
+	ExpirationTime int64                 `protobuf:"varint,5,opt,name=ExpirationTime,proto3" json:"ExpirationTime,omitempty"`
+	Products       *SearchProductsResult `protobuf:"bytes,6,opt,name=Products,proto3" json:"Products,omitempty"`
+	Facets         *FacetsResult         `protobuf:"bytes,7,opt,name=Facets,proto3" json:"Facets,omitempty"`
+}
+
+func (x *AsyncSearchStatus) Reset() {
+	*x = AsyncSearchStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AsyncSearchStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncSearchStatus) ProtoMessage() {}
+
+func (x *AsyncSearchStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncSearchStatus.ProtoReflect.Descriptor instead.
+func (*AsyncSearchStatus) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AsyncSearchStatus) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *AsyncSearchStatus) GetIsRunning() bool {
+	if x != nil {
+		return x.IsRunning
+	}
+	return false
+}
+
+func (x *AsyncSearchStatus) GetIsPartial() bool {
+	if x != nil {
+		return x.IsPartial
+	}
+	return false
+}
+
+func (x *AsyncSearchStatus) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *AsyncSearchStatus) GetExpirationTime() int64 {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return 0
+}
+
+func (x *AsyncSearchStatus) GetProducts() *SearchProductsResult {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *AsyncSearchStatus) GetFacets() *FacetsResult {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+// 异步搜索取消结果
+type CancelSearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Canceled bool `protobuf:"varint,1,opt,name=Canceled,proto3" json:"Canceled,omitempty"`
+}
+
+func (x *CancelSearchResult) Reset() {
+	*x = CancelSearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_messages_productsearch_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSearchResult) ProtoMessage() {}
+
+func (x *CancelSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_messages_productsearch_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSearchResult.ProtoReflect.Descriptor instead.
+func (*CancelSearchResult) Descriptor() ([]byte, []int) {
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CancelSearchResult) GetCanceled() bool {
+	if x != nil {
+		return x.Canceled
+	}
+	return false
+}
+
 type Tokens struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -79,7 +1575,7 @@ type Tokens struct {
 func (x *Tokens) Reset() {
 	*x = Tokens{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_messages_productsearch_proto_msgTypes[1]
+		mi := &file_protos_messages_productsearch_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -92,7 +1588,7 @@ func (x *Tokens) String() string {
 func (*Tokens) ProtoMessage() {}
 
 func (x *Tokens) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_messages_productsearch_proto_msgTypes[1]
+	mi := &file_protos_messages_productsearch_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -105,7 +1601,7 @@ func (x *Tokens) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tokens.ProtoReflect.Descriptor instead.
 func (*Tokens) Descriptor() ([]byte, []int) {
-	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{1}
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *Tokens) GetTokens() []*Token {
@@ -131,7 +1627,7 @@ type Token struct {
 func (x *Token) Reset() {
 	*x = Token{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_messages_productsearch_proto_msgTypes[2]
+		mi := &file_protos_messages_productsearch_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -144,7 +1640,7 @@ func (x *Token) String() string {
 func (*Token) ProtoMessage() {}
 
 func (x *Token) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_messages_productsearch_proto_msgTypes[2]
+	mi := &file_protos_messages_productsearch_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -157,7 +1653,7 @@ func (x *Token) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Token.ProtoReflect.Descriptor instead.
 func (*Token) Descriptor() ([]byte, []int) {
-	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{2}
+	return file_protos_messages_productsearch_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Token) GetToken() string {
@@ -208,27 +1704,276 @@ var file_protos_messages_productsearch_proto_rawDesc = []byte{
 	0x0a, 0x23, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x73, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22,
-	0x2e, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x22,
-	0x31, 0x0a, 0x06, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x45, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x6c, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x6c, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x37,
-	0x5a, 0x19, 0x65, 0x61, 0x73, 0x79, 0x6d, 0x73, 0x2d, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0xaa, 0x02, 0x19, 0x47, 0x72,
-	0x70, 0x63, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0xa9, 0x03, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x57, 0x6f, 0x72,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x50, 0x61, 0x73, 0x73,
+	0x69, 0x76, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x50, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x49, 0x44, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x72, 0x61, 0x6e,
+	0x64, 0x49, 0x44, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x42, 0x72, 0x61, 0x6e,
+	0x64, 0x49, 0x44, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x6f, 0x72, 0x49, 0x44, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x73, 0x12, 0x33, 0x0a, 0x0a,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x5b, 0x0a, 0x09, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28,
+	0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x62, 0x6f, 0x76, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x41, 0x62, 0x6f, 0x76, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x73, 0x52, 0x65, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x49, 0x73, 0x52, 0x65, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x13, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x13, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x45, 0x53, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0x95, 0x03, 0x0a, 0x09, 0x45, 0x53, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49, 0x44,
+	0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x72, 0x61, 0x6e,
+	0x64, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x42, 0x72, 0x61, 0x6e, 0x64,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x26,
+	0x0a, 0x0e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x69, 0x63, 0x55, 0x72, 0x6c, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x69, 0x63, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x50, 0x64, 0x66, 0x55, 0x72, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x64,
+	0x66, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x32, 0x0a, 0x14, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x14, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x46, 0x61, 0x63, 0x65,
+	0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x32, 0x0a, 0x05,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x0b, 0x46, 0x61, 0x63, 0x65, 0x74, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x08, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0xc9, 0x02, 0x0a, 0x0c, 0x46, 0x61,
+	0x63, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x2d, 0x0a, 0x06, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63,
+	0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x73,
+	0x12, 0x39, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x0c, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0e, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46,
+	0x61, 0x63, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x0e, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46,
+	0x61, 0x63, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x0f, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x49,
+	0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a,
+	0x08, 0x49, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x49, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4c, 0x61, 0x73,
+	0x74, 0x50, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x4c, 0x61, 0x73, 0x74,
+	0x50, 0x49, 0x44, 0x22, 0x6f, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4c, 0x61, 0x73, 0x74, 0x50, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x4c, 0x61, 0x73, 0x74, 0x50, 0x49, 0x44, 0x12,
+	0x27, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45, 0x53, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x07, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x22,
+	0x25, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x0b, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x15, 0x0a, 0x03, 0x47,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x03, 0x47, 0x74, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x4c, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x01, 0x52, 0x03, 0x4c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x47, 0x74,
+	0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x4c, 0x74, 0x65, 0x22, 0xac, 0x06, 0x0a, 0x18, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x4c, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x57, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4b, 0x65, 0x79,
+	0x57, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x4b, 0x65, 0x79, 0x57,
+	0x6f, 0x72, 0x64, 0x73, 0x12, 0x49, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x58, 0x0a, 0x0c, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x54, 0x65, 0x72,
+	0x6d, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x05, 0x53, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x53, 0x6f, 0x72, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x42, 0x6f,
+	0x6f, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x48, 0x69, 0x67, 0x68,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f,
+	0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x1a, 0x52, 0x0a,
+	0x0d, 0x4b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x57, 0x6f,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a,
+	0x11, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38,
+	0x0a, 0x0a, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd1, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x12, 0x18, 0x0a, 0x07, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x76, 0x0a, 0x13,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x45, 0x53, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x32, 0x0a, 0x05, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x57, 0x69, 0x74, 0x68, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x57, 0x69, 0x74, 0x68, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x46, 0x61, 0x63, 0x65, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x46, 0x61, 0x63, 0x65, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x1f, 0x0a, 0x0d,
+	0x41, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x44, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x91, 0x02,
+	0x0a, 0x11, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12,
+	0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x73, 0x12, 0x2e, 0x0a, 0x06, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x46, 0x61, 0x63, 0x65, 0x74,
+	0x73, 0x22, 0x30, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x65, 0x64, 0x22, 0x31, 0x0a, 0x06, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x27, 0x0a,
+	0x06, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x6e, 0x64, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x45, 0x6e, 0x64,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x6c, 0x64, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x6c, 0x64, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x42, 0x37, 0x5a, 0x19, 0x65, 0x61, 0x73, 0x79, 0x6d, 0x73, 0x2d, 0x65, 0x73, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0xaa,
+	0x02, 0x19, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -243,19 +1988,62 @@ func file_protos_messages_productsearch_proto_rawDescGZIP() []byte {
 	return file_protos_messages_productsearch_proto_rawDescData
 }
 
-var file_protos_messages_productsearch_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_protos_messages_productsearch_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_protos_messages_productsearch_proto_goTypes = []any{
-	(*ProductSearchParam)(nil), // 0: messages.ProductSearchParam
-	(*Tokens)(nil),             // 1: messages.Tokens
-	(*Token)(nil),              // 2: messages.Token
+	(*ProductSearchParam)(nil),       // 0: messages.ProductSearchParam
+	(*Attribute)(nil),                // 1: messages.Attribute
+	(*SearchProductsResult)(nil),     // 2: messages.SearchProductsResult
+	(*ESProduct)(nil),                // 3: messages.ESProduct
+	(*FacetSearchParam)(nil),         // 4: messages.FacetSearchParam
+	(*FacetBucket)(nil),              // 5: messages.FacetBucket
+	(*FacetsResult)(nil),             // 6: messages.FacetsResult
+	(*ProductIndexSearchParam)(nil),  // 7: messages.ProductIndexSearchParam
+	(*ProductIndexCount)(nil),        // 8: messages.ProductIndexCount
+	(*ProductIndexResult)(nil),       // 9: messages.ProductIndexResult
+	(*KeyWordList)(nil),              // 10: messages.KeyWordList
+	(*CustomRange)(nil),              // 11: messages.CustomRange
+	(*CustomSearchProductParam)(nil), // 12: messages.CustomSearchProductParam
+	(*ExportProductsParam)(nil),      // 13: messages.ExportProductsParam
+	(*ExportProductsChunk)(nil),      // 14: messages.ExportProductsChunk
+	(*SubmitSearchParam)(nil),        // 15: messages.SubmitSearchParam
+	(*AsyncSearchID)(nil),            // 16: messages.AsyncSearchID
+	(*AsyncSearchStatus)(nil),        // 17: messages.AsyncSearchStatus
+	(*CancelSearchResult)(nil),       // 18: messages.CancelSearchResult
+	(*Tokens)(nil),                   // 19: messages.Tokens
+	(*Token)(nil),                    // 20: messages.Token
+	nil,                              // 21: messages.CustomSearchProductParam.KeyWordsEntry
+	nil,                              // 22: messages.CustomSearchProductParam.FiltersEntry
+	nil,                              // 23: messages.CustomSearchProductParam.TermsFiltersEntry
+	nil,                              // 24: messages.CustomSearchProductParam.SortsEntry
 }
 var file_protos_messages_productsearch_proto_depIdxs = []int32{
-	2, // 0: messages.Tokens.Tokens:type_name -> messages.Token
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1,  // 0: messages.ProductSearchParam.Attributes:type_name -> messages.Attribute
+	3,  // 1: messages.SearchProductsResult.Data:type_name -> messages.ESProduct
+	0,  // 2: messages.FacetSearchParam.Query:type_name -> messages.ProductSearchParam
+	5,  // 3: messages.FacetBucket.Children:type_name -> messages.FacetBucket
+	5,  // 4: messages.FacetsResult.Categories:type_name -> messages.FacetBucket
+	5,  // 5: messages.FacetsResult.Brands:type_name -> messages.FacetBucket
+	5,  // 6: messages.FacetsResult.Distributors:type_name -> messages.FacetBucket
+	5,  // 7: messages.FacetsResult.AttributeNames:type_name -> messages.FacetBucket
+	5,  // 8: messages.FacetsResult.AttributeValues:type_name -> messages.FacetBucket
+	3,  // 9: messages.ProductIndexResult.Data:type_name -> messages.ESProduct
+	8,  // 10: messages.ProductIndexResult.Indexes:type_name -> messages.ProductIndexCount
+	21, // 11: messages.CustomSearchProductParam.KeyWords:type_name -> messages.CustomSearchProductParam.KeyWordsEntry
+	22, // 12: messages.CustomSearchProductParam.Filters:type_name -> messages.CustomSearchProductParam.FiltersEntry
+	23, // 13: messages.CustomSearchProductParam.TermsFilters:type_name -> messages.CustomSearchProductParam.TermsFiltersEntry
+	11, // 14: messages.CustomSearchProductParam.Range:type_name -> messages.CustomRange
+	24, // 15: messages.CustomSearchProductParam.Sorts:type_name -> messages.CustomSearchProductParam.SortsEntry
+	3,  // 16: messages.ExportProductsChunk.Data:type_name -> messages.ESProduct
+	0,  // 17: messages.SubmitSearchParam.Query:type_name -> messages.ProductSearchParam
+	2,  // 18: messages.AsyncSearchStatus.Products:type_name -> messages.SearchProductsResult
+	6,  // 19: messages.AsyncSearchStatus.Facets:type_name -> messages.FacetsResult
+	20, // 20: messages.Tokens.Tokens:type_name -> messages.Token
+	10, // 21: messages.CustomSearchProductParam.KeyWordsEntry.value:type_name -> messages.KeyWordList
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_protos_messages_productsearch_proto_init() }
@@ -277,7 +2065,7 @@ func file_protos_messages_productsearch_proto_init() {
 			}
 		}
 		file_protos_messages_productsearch_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*Tokens); i {
+			switch v := v.(*Attribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -289,6 +2077,222 @@ func file_protos_messages_productsearch_proto_init() {
 			}
 		}
 		file_protos_messages_productsearch_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchProductsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ESProduct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*FacetSearchParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*FacetBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*FacetsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductIndexSearchParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductIndexCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ProductIndexResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*KeyWordList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*CustomRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*CustomSearchProductParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportProductsParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportProductsChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*SubmitSearchParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*AsyncSearchID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*AsyncSearchStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelSearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*Tokens); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_messages_productsearch_proto_msgTypes[20].Exporter = func(v any, i int) any {
 			switch v := v.(*Token); i {
 			case 0:
 				return &v.state
@@ -301,13 +2305,14 @@ func file_protos_messages_productsearch_proto_init() {
 			}
 		}
 	}
+	file_protos_messages_productsearch_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_messages_productsearch_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
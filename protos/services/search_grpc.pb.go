@@ -20,7 +20,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductsSearchService_Analyze_FullMethodName = "/services.ProductsSearchService/Analyze"
+	ProductsSearchService_Analyze_FullMethodName              = "/services.ProductsSearchService/Analyze"
+	ProductsSearchService_SearchProducts_FullMethodName       = "/services.ProductsSearchService/SearchProducts"
+	ProductsSearchService_SearchFacets_FullMethodName         = "/services.ProductsSearchService/SearchFacets"
+	ProductsSearchService_BrowseProductIndex_FullMethodName   = "/services.ProductsSearchService/BrowseProductIndex"
+	ProductsSearchService_CustomSearchProducts_FullMethodName = "/services.ProductsSearchService/CustomSearchProducts"
+	ProductsSearchService_ExportProducts_FullMethodName       = "/services.ProductsSearchService/ExportProducts"
+	ProductsSearchService_SubmitSearch_FullMethodName         = "/services.ProductsSearchService/SubmitSearch"
+	ProductsSearchService_GetSearchStatus_FullMethodName      = "/services.ProductsSearchService/GetSearchStatus"
+	ProductsSearchService_CancelSearch_FullMethodName         = "/services.ProductsSearchService/CancelSearch"
 )
 
 // ProductsSearchServiceClient is the client API for ProductsSearchService service.
@@ -29,6 +37,22 @@ const (
 type ProductsSearchServiceClient interface {
 	// 搜索关键词分析
 	Analyze(ctx context.Context, in *messages.ProductSearchParam, opts ...grpc.CallOption) (*messages.Tokens, error)
+	// 产品关键词及条件搜索
+	SearchProducts(ctx context.Context, in *messages.ProductSearchParam, opts ...grpc.CallOption) (*messages.SearchProductsResult, error)
+	// 分面导航(分类,品牌,分销商,属性)聚合
+	SearchFacets(ctx context.Context, in *messages.FacetSearchParam, opts ...grpc.CallOption) (*messages.FacetsResult, error)
+	// 型号首字母索引浏览
+	BrowseProductIndex(ctx context.Context, in *messages.ProductIndexSearchParam, opts ...grpc.CallOption) (*messages.ProductIndexResult, error)
+	// 自定义产品搜索, 字段需在白名单内
+	CustomSearchProducts(ctx context.Context, in *messages.CustomSearchProductParam, opts ...grpc.CallOption) (*messages.SearchProductsResult, error)
+	// 产品导出(服务端流), 支持断点续传
+	ExportProducts(ctx context.Context, in *messages.ExportProductsParam, opts ...grpc.CallOption) (grpc.ServerStreamingClient[messages.ExportProductsChunk], error)
+	// 提交异步搜索
+	SubmitSearch(ctx context.Context, in *messages.SubmitSearchParam, opts ...grpc.CallOption) (*messages.AsyncSearchID, error)
+	// 异步搜索状态及结果
+	GetSearchStatus(ctx context.Context, in *messages.AsyncSearchID, opts ...grpc.CallOption) (*messages.AsyncSearchStatus, error)
+	// 取消异步搜索
+	CancelSearch(ctx context.Context, in *messages.AsyncSearchID, opts ...grpc.CallOption) (*messages.CancelSearchResult, error)
 }
 
 type productsSearchServiceClient struct {
@@ -49,12 +73,117 @@ func (c *productsSearchServiceClient) Analyze(ctx context.Context, in *messages.
 	return out, nil
 }
 
+func (c *productsSearchServiceClient) SearchProducts(ctx context.Context, in *messages.ProductSearchParam, opts ...grpc.CallOption) (*messages.SearchProductsResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(messages.SearchProductsResult)
+	err := c.cc.Invoke(ctx, ProductsSearchService_SearchProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsSearchServiceClient) SearchFacets(ctx context.Context, in *messages.FacetSearchParam, opts ...grpc.CallOption) (*messages.FacetsResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(messages.FacetsResult)
+	err := c.cc.Invoke(ctx, ProductsSearchService_SearchFacets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsSearchServiceClient) BrowseProductIndex(ctx context.Context, in *messages.ProductIndexSearchParam, opts ...grpc.CallOption) (*messages.ProductIndexResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(messages.ProductIndexResult)
+	err := c.cc.Invoke(ctx, ProductsSearchService_BrowseProductIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsSearchServiceClient) CustomSearchProducts(ctx context.Context, in *messages.CustomSearchProductParam, opts ...grpc.CallOption) (*messages.SearchProductsResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(messages.SearchProductsResult)
+	err := c.cc.Invoke(ctx, ProductsSearchService_CustomSearchProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsSearchServiceClient) ExportProducts(ctx context.Context, in *messages.ExportProductsParam, opts ...grpc.CallOption) (grpc.ServerStreamingClient[messages.ExportProductsChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductsSearchService_ServiceDesc.Streams[0], ProductsSearchService_ExportProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[messages.ExportProductsParam, messages.ExportProductsChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductsSearchService_ExportProductsClient = grpc.ServerStreamingClient[messages.ExportProductsChunk]
+
+func (c *productsSearchServiceClient) SubmitSearch(ctx context.Context, in *messages.SubmitSearchParam, opts ...grpc.CallOption) (*messages.AsyncSearchID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(messages.AsyncSearchID)
+	err := c.cc.Invoke(ctx, ProductsSearchService_SubmitSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsSearchServiceClient) GetSearchStatus(ctx context.Context, in *messages.AsyncSearchID, opts ...grpc.CallOption) (*messages.AsyncSearchStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(messages.AsyncSearchStatus)
+	err := c.cc.Invoke(ctx, ProductsSearchService_GetSearchStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsSearchServiceClient) CancelSearch(ctx context.Context, in *messages.AsyncSearchID, opts ...grpc.CallOption) (*messages.CancelSearchResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(messages.CancelSearchResult)
+	err := c.cc.Invoke(ctx, ProductsSearchService_CancelSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductsSearchServiceServer is the server API for ProductsSearchService service.
 // All implementations must embed UnimplementedProductsSearchServiceServer
 // for forward compatibility.
 type ProductsSearchServiceServer interface {
 	// 搜索关键词分析
 	Analyze(context.Context, *messages.ProductSearchParam) (*messages.Tokens, error)
+	// 产品关键词及条件搜索
+	SearchProducts(context.Context, *messages.ProductSearchParam) (*messages.SearchProductsResult, error)
+	// 分面导航(分类,品牌,分销商,属性)聚合
+	SearchFacets(context.Context, *messages.FacetSearchParam) (*messages.FacetsResult, error)
+	// 型号首字母索引浏览
+	BrowseProductIndex(context.Context, *messages.ProductIndexSearchParam) (*messages.ProductIndexResult, error)
+	// 自定义产品搜索, 字段需在白名单内
+	CustomSearchProducts(context.Context, *messages.CustomSearchProductParam) (*messages.SearchProductsResult, error)
+	// 产品导出(服务端流), 支持断点续传
+	ExportProducts(*messages.ExportProductsParam, grpc.ServerStreamingServer[messages.ExportProductsChunk]) error
+	// 提交异步搜索
+	SubmitSearch(context.Context, *messages.SubmitSearchParam) (*messages.AsyncSearchID, error)
+	// 异步搜索状态及结果
+	GetSearchStatus(context.Context, *messages.AsyncSearchID) (*messages.AsyncSearchStatus, error)
+	// 取消异步搜索
+	CancelSearch(context.Context, *messages.AsyncSearchID) (*messages.CancelSearchResult, error)
 	mustEmbedUnimplementedProductsSearchServiceServer()
 }
 
@@ -68,6 +197,30 @@ type UnimplementedProductsSearchServiceServer struct{}
 func (UnimplementedProductsSearchServiceServer) Analyze(context.Context, *messages.ProductSearchParam) (*messages.Tokens, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Analyze not implemented")
 }
+func (UnimplementedProductsSearchServiceServer) SearchProducts(context.Context, *messages.ProductSearchParam) (*messages.SearchProductsResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
+func (UnimplementedProductsSearchServiceServer) SearchFacets(context.Context, *messages.FacetSearchParam) (*messages.FacetsResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchFacets not implemented")
+}
+func (UnimplementedProductsSearchServiceServer) BrowseProductIndex(context.Context, *messages.ProductIndexSearchParam) (*messages.ProductIndexResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BrowseProductIndex not implemented")
+}
+func (UnimplementedProductsSearchServiceServer) CustomSearchProducts(context.Context, *messages.CustomSearchProductParam) (*messages.SearchProductsResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CustomSearchProducts not implemented")
+}
+func (UnimplementedProductsSearchServiceServer) ExportProducts(*messages.ExportProductsParam, grpc.ServerStreamingServer[messages.ExportProductsChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportProducts not implemented")
+}
+func (UnimplementedProductsSearchServiceServer) SubmitSearch(context.Context, *messages.SubmitSearchParam) (*messages.AsyncSearchID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSearch not implemented")
+}
+func (UnimplementedProductsSearchServiceServer) GetSearchStatus(context.Context, *messages.AsyncSearchID) (*messages.AsyncSearchStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSearchStatus not implemented")
+}
+func (UnimplementedProductsSearchServiceServer) CancelSearch(context.Context, *messages.AsyncSearchID) (*messages.CancelSearchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSearch not implemented")
+}
 func (UnimplementedProductsSearchServiceServer) mustEmbedUnimplementedProductsSearchServiceServer() {}
 func (UnimplementedProductsSearchServiceServer) testEmbeddedByValue()                               {}
 
@@ -107,6 +260,143 @@ func _ProductsSearchService_Analyze_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductsSearchService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.ProductSearchParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsSearchServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsSearchService_SearchProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsSearchServiceServer).SearchProducts(ctx, req.(*messages.ProductSearchParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductsSearchService_SearchFacets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.FacetSearchParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsSearchServiceServer).SearchFacets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsSearchService_SearchFacets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsSearchServiceServer).SearchFacets(ctx, req.(*messages.FacetSearchParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductsSearchService_BrowseProductIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.ProductIndexSearchParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsSearchServiceServer).BrowseProductIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsSearchService_BrowseProductIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsSearchServiceServer).BrowseProductIndex(ctx, req.(*messages.ProductIndexSearchParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductsSearchService_CustomSearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.CustomSearchProductParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsSearchServiceServer).CustomSearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsSearchService_CustomSearchProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsSearchServiceServer).CustomSearchProducts(ctx, req.(*messages.CustomSearchProductParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductsSearchService_ExportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(messages.ExportProductsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductsSearchServiceServer).ExportProducts(m, &grpc.GenericServerStream[messages.ExportProductsParam, messages.ExportProductsChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductsSearchService_ExportProductsServer = grpc.ServerStreamingServer[messages.ExportProductsChunk]
+
+func _ProductsSearchService_SubmitSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.SubmitSearchParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsSearchServiceServer).SubmitSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsSearchService_SubmitSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsSearchServiceServer).SubmitSearch(ctx, req.(*messages.SubmitSearchParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductsSearchService_GetSearchStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.AsyncSearchID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsSearchServiceServer).GetSearchStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsSearchService_GetSearchStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsSearchServiceServer).GetSearchStatus(ctx, req.(*messages.AsyncSearchID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductsSearchService_CancelSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.AsyncSearchID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsSearchServiceServer).CancelSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductsSearchService_CancelSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsSearchServiceServer).CancelSearch(ctx, req.(*messages.AsyncSearchID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductsSearchService_ServiceDesc is the grpc.ServiceDesc for ProductsSearchService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -118,13 +408,50 @@ var ProductsSearchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Analyze",
 			Handler:    _ProductsSearchService_Analyze_Handler,
 		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _ProductsSearchService_SearchProducts_Handler,
+		},
+		{
+			MethodName: "SearchFacets",
+			Handler:    _ProductsSearchService_SearchFacets_Handler,
+		},
+		{
+			MethodName: "BrowseProductIndex",
+			Handler:    _ProductsSearchService_BrowseProductIndex_Handler,
+		},
+		{
+			MethodName: "CustomSearchProducts",
+			Handler:    _ProductsSearchService_CustomSearchProducts_Handler,
+		},
+		{
+			MethodName: "SubmitSearch",
+			Handler:    _ProductsSearchService_SubmitSearch_Handler,
+		},
+		{
+			MethodName: "GetSearchStatus",
+			Handler:    _ProductsSearchService_GetSearchStatus_Handler,
+		},
+		{
+			MethodName: "CancelSearch",
+			Handler:    _ProductsSearchService_CancelSearch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportProducts",
+			Handler:       _ProductsSearchService_ExportProducts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/services/search.proto",
 }
 
 const (
-	PriceSearchService_SearchPrices_FullMethodName = "/services.PriceSearchService/SearchPrices"
+	PriceSearchService_SearchPrices_FullMethodName           = "/services.PriceSearchService/SearchPrices"
+	PriceSearchService_SearchPricesByProducts_FullMethodName = "/services.PriceSearchService/SearchPricesByProducts"
+	PriceSearchService_QuotePrices_FullMethodName            = "/services.PriceSearchService/QuotePrices"
+	PriceSearchService_ExportPrices_FullMethodName           = "/services.PriceSearchService/ExportPrices"
 )
 
 // PriceSearchServiceClient is the client API for PriceSearchService service.
@@ -133,6 +460,12 @@ const (
 type PriceSearchServiceClient interface {
 	// 单产品的价格搜索
 	SearchPrices(ctx context.Context, in *messages.PriceSearchParam, opts ...grpc.CallOption) (*messages.SearchPricesResult, error)
+	// 多产品的价格搜索, 每个产品返回排序靠前的价格
+	SearchPricesByProducts(ctx context.Context, in *messages.ProductsPriceSearchParam, opts ...grpc.CallOption) (*messages.SearchPricesByProductsResult, error)
+	// 按数量报价
+	QuotePrices(ctx context.Context, in *messages.QuoteParam, opts ...grpc.CallOption) (*messages.QuotePricesResult, error)
+	// 价格导出(服务端流), 支持断点续传
+	ExportPrices(ctx context.Context, in *messages.ExportPricesParam, opts ...grpc.CallOption) (grpc.ServerStreamingClient[messages.ExportPricesChunk], error)
 }
 
 type priceSearchServiceClient struct {
@@ -153,12 +486,57 @@ func (c *priceSearchServiceClient) SearchPrices(ctx context.Context, in *message
 	return out, nil
 }
 
+func (c *priceSearchServiceClient) SearchPricesByProducts(ctx context.Context, in *messages.ProductsPriceSearchParam, opts ...grpc.CallOption) (*messages.SearchPricesByProductsResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(messages.SearchPricesByProductsResult)
+	err := c.cc.Invoke(ctx, PriceSearchService_SearchPricesByProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceSearchServiceClient) QuotePrices(ctx context.Context, in *messages.QuoteParam, opts ...grpc.CallOption) (*messages.QuotePricesResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(messages.QuotePricesResult)
+	err := c.cc.Invoke(ctx, PriceSearchService_QuotePrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceSearchServiceClient) ExportPrices(ctx context.Context, in *messages.ExportPricesParam, opts ...grpc.CallOption) (grpc.ServerStreamingClient[messages.ExportPricesChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PriceSearchService_ServiceDesc.Streams[0], PriceSearchService_ExportPrices_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[messages.ExportPricesParam, messages.ExportPricesChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PriceSearchService_ExportPricesClient = grpc.ServerStreamingClient[messages.ExportPricesChunk]
+
 // PriceSearchServiceServer is the server API for PriceSearchService service.
 // All implementations must embed UnimplementedPriceSearchServiceServer
 // for forward compatibility.
 type PriceSearchServiceServer interface {
 	// 单产品的价格搜索
 	SearchPrices(context.Context, *messages.PriceSearchParam) (*messages.SearchPricesResult, error)
+	// 多产品的价格搜索, 每个产品返回排序靠前的价格
+	SearchPricesByProducts(context.Context, *messages.ProductsPriceSearchParam) (*messages.SearchPricesByProductsResult, error)
+	// 按数量报价
+	QuotePrices(context.Context, *messages.QuoteParam) (*messages.QuotePricesResult, error)
+	// 价格导出(服务端流), 支持断点续传
+	ExportPrices(*messages.ExportPricesParam, grpc.ServerStreamingServer[messages.ExportPricesChunk]) error
 	mustEmbedUnimplementedPriceSearchServiceServer()
 }
 
@@ -172,6 +550,15 @@ type UnimplementedPriceSearchServiceServer struct{}
 func (UnimplementedPriceSearchServiceServer) SearchPrices(context.Context, *messages.PriceSearchParam) (*messages.SearchPricesResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchPrices not implemented")
 }
+func (UnimplementedPriceSearchServiceServer) SearchPricesByProducts(context.Context, *messages.ProductsPriceSearchParam) (*messages.SearchPricesByProductsResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPricesByProducts not implemented")
+}
+func (UnimplementedPriceSearchServiceServer) QuotePrices(context.Context, *messages.QuoteParam) (*messages.QuotePricesResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotePrices not implemented")
+}
+func (UnimplementedPriceSearchServiceServer) ExportPrices(*messages.ExportPricesParam, grpc.ServerStreamingServer[messages.ExportPricesChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportPrices not implemented")
+}
 func (UnimplementedPriceSearchServiceServer) mustEmbedUnimplementedPriceSearchServiceServer() {}
 func (UnimplementedPriceSearchServiceServer) testEmbeddedByValue()                            {}
 
@@ -211,6 +598,53 @@ func _PriceSearchService_SearchPrices_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PriceSearchService_SearchPricesByProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.ProductsPriceSearchParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceSearchServiceServer).SearchPricesByProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceSearchService_SearchPricesByProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceSearchServiceServer).SearchPricesByProducts(ctx, req.(*messages.ProductsPriceSearchParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceSearchService_QuotePrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.QuoteParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceSearchServiceServer).QuotePrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceSearchService_QuotePrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceSearchServiceServer).QuotePrices(ctx, req.(*messages.QuoteParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceSearchService_ExportPrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(messages.ExportPricesParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PriceSearchServiceServer).ExportPrices(m, &grpc.GenericServerStream[messages.ExportPricesParam, messages.ExportPricesChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PriceSearchService_ExportPricesServer = grpc.ServerStreamingServer[messages.ExportPricesChunk]
+
 // PriceSearchService_ServiceDesc is the grpc.ServiceDesc for PriceSearchService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -222,6 +656,348 @@ var PriceSearchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchPrices",
 			Handler:    _PriceSearchService_SearchPrices_Handler,
 		},
+		{
+			MethodName: "SearchPricesByProducts",
+			Handler:    _PriceSearchService_SearchPricesByProducts_Handler,
+		},
+		{
+			MethodName: "QuotePrices",
+			Handler:    _PriceSearchService_QuotePrices_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportPrices",
+			Handler:       _PriceSearchService_ExportPrices_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "protos/services/search.proto",
+}
+
+const (
+	BomService_MatchBOM_FullMethodName = "/services.BomService/MatchBOM"
+)
+
+// BomServiceClient is the client API for BomService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BomServiceClient interface {
+	// BOM批量匹配及报价
+	MatchBOM(ctx context.Context, in *messages.MatchBOMParam, opts ...grpc.CallOption) (*messages.MatchBOMResult, error)
+}
+
+type bomServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBomServiceClient(cc grpc.ClientConnInterface) BomServiceClient {
+	return &bomServiceClient{cc}
+}
+
+func (c *bomServiceClient) MatchBOM(ctx context.Context, in *messages.MatchBOMParam, opts ...grpc.CallOption) (*messages.MatchBOMResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(messages.MatchBOMResult)
+	err := c.cc.Invoke(ctx, BomService_MatchBOM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BomServiceServer is the server API for BomService service.
+// All implementations must embed UnimplementedBomServiceServer
+// for forward compatibility.
+type BomServiceServer interface {
+	// BOM批量匹配及报价
+	MatchBOM(context.Context, *messages.MatchBOMParam) (*messages.MatchBOMResult, error)
+	mustEmbedUnimplementedBomServiceServer()
+}
+
+// UnimplementedBomServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBomServiceServer struct{}
+
+func (UnimplementedBomServiceServer) MatchBOM(context.Context, *messages.MatchBOMParam) (*messages.MatchBOMResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MatchBOM not implemented")
+}
+func (UnimplementedBomServiceServer) mustEmbedUnimplementedBomServiceServer() {}
+func (UnimplementedBomServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeBomServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BomServiceServer will
+// result in compilation errors.
+type UnsafeBomServiceServer interface {
+	mustEmbedUnimplementedBomServiceServer()
+}
+
+func RegisterBomServiceServer(s grpc.ServiceRegistrar, srv BomServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBomServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BomService_ServiceDesc, srv)
+}
+
+func _BomService_MatchBOM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.MatchBOMParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BomServiceServer).MatchBOM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BomService_MatchBOM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BomServiceServer).MatchBOM(ctx, req.(*messages.MatchBOMParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BomService_ServiceDesc is the grpc.ServiceDesc for BomService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BomService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.BomService",
+	HandlerType: (*BomServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MatchBOM",
+			Handler:    _BomService_MatchBOM_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protos/services/search.proto",
+}
+
+const (
+	AdminService_UpsertProducts_FullMethodName = "/services.AdminService/UpsertProducts"
+	AdminService_DeleteProducts_FullMethodName = "/services.AdminService/DeleteProducts"
+	AdminService_UpsertPrices_FullMethodName   = "/services.AdminService/UpsertPrices"
+	AdminService_DeletePrices_FullMethodName   = "/services.AdminService/DeletePrices"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	// 写入或覆盖产品
+	UpsertProducts(ctx context.Context, in *messages.UpsertProductsParam, opts ...grpc.CallOption) (*messages.WriteResult, error)
+	// 删除产品
+	DeleteProducts(ctx context.Context, in *messages.DeleteProductsParam, opts ...grpc.CallOption) (*messages.WriteResult, error)
+	// 写入或覆盖价格
+	UpsertPrices(ctx context.Context, in *messages.UpsertPricesParam, opts ...grpc.CallOption) (*messages.WriteResult, error)
+	// 删除价格
+	DeletePrices(ctx context.Context, in *messages.DeletePricesParam, opts ...grpc.CallOption) (*messages.WriteResult, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) UpsertProducts(ctx context.Context, in *messages.UpsertProductsParam, opts ...grpc.CallOption) (*messages.WriteResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(messages.WriteResult)
+	err := c.cc.Invoke(ctx, AdminService_UpsertProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteProducts(ctx context.Context, in *messages.DeleteProductsParam, opts ...grpc.CallOption) (*messages.WriteResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(messages.WriteResult)
+	err := c.cc.Invoke(ctx, AdminService_DeleteProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpsertPrices(ctx context.Context, in *messages.UpsertPricesParam, opts ...grpc.CallOption) (*messages.WriteResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(messages.WriteResult)
+	err := c.cc.Invoke(ctx, AdminService_UpsertPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeletePrices(ctx context.Context, in *messages.DeletePricesParam, opts ...grpc.CallOption) (*messages.WriteResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(messages.WriteResult)
+	err := c.cc.Invoke(ctx, AdminService_DeletePrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+type AdminServiceServer interface {
+	// 写入或覆盖产品
+	UpsertProducts(context.Context, *messages.UpsertProductsParam) (*messages.WriteResult, error)
+	// 删除产品
+	DeleteProducts(context.Context, *messages.DeleteProductsParam) (*messages.WriteResult, error)
+	// 写入或覆盖价格
+	UpsertPrices(context.Context, *messages.UpsertPricesParam) (*messages.WriteResult, error)
+	// 删除价格
+	DeletePrices(context.Context, *messages.DeletePricesParam) (*messages.WriteResult, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) UpsertProducts(context.Context, *messages.UpsertProductsParam) (*messages.WriteResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertProducts not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteProducts(context.Context, *messages.DeleteProductsParam) (*messages.WriteResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProducts not implemented")
+}
+func (UnimplementedAdminServiceServer) UpsertPrices(context.Context, *messages.UpsertPricesParam) (*messages.WriteResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertPrices not implemented")
+}
+func (UnimplementedAdminServiceServer) DeletePrices(context.Context, *messages.DeletePricesParam) (*messages.WriteResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePrices not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_UpsertProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.UpsertProductsParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpsertProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpsertProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpsertProducts(ctx, req.(*messages.UpsertProductsParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.DeleteProductsParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteProducts(ctx, req.(*messages.DeleteProductsParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpsertPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.UpsertPricesParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpsertPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpsertPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpsertPrices(ctx, req.(*messages.UpsertPricesParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeletePrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(messages.DeletePricesParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeletePrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeletePrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeletePrices(ctx, req.(*messages.DeletePricesParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpsertProducts",
+			Handler:    _AdminService_UpsertProducts_Handler,
+		},
+		{
+			MethodName: "DeleteProducts",
+			Handler:    _AdminService_DeleteProducts_Handler,
+		},
+		{
+			MethodName: "UpsertPrices",
+			Handler:    _AdminService_UpsertPrices_Handler,
+		},
+		{
+			MethodName: "DeletePrices",
+			Handler:    _AdminService_DeletePrices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/services/search.proto",
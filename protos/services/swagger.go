@@ -0,0 +1,8 @@
+package services
+
+import _ "embed"
+
+// SwaggerJSON 生成的 OpenAPI 文档, 由网关提供给 Swagger UI
+//
+//go:embed search.swagger.json
+var SwaggerJSON []byte
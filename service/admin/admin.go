@@ -0,0 +1,184 @@
+// Package admin 文档实时写入, 上游系统直接推送产品及价格变更, 不再等待定时作业
+package admin
+
+import (
+	"context"
+	"easyms-es/easyes"
+	"easyms-es/errno"
+	"easyms-es/model"
+	"easyms-es/service/models"
+	"easyms-es/service/prices"
+	"easyms-es/service/products"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Refresh 默认刷新策略, 由配置 common.admin.refresh 覆盖
+var Refresh = easyes.RefreshWaitFor
+
+// 单次写入的最大条数
+const maxWriteSize = 1000
+
+// 单条写入结果
+const (
+	ResultInvalid = "invalid"
+	ResultError   = "error"
+)
+
+// UpsertProducts 写入或覆盖产品, 文档由 products.BuildProduct 生成, 校验失败的条目不写入
+func UpsertProducts(ctx context.Context, sources []model.ProductSource, refresh string) (models.WriteResult, error) {
+	ids := make([]string, len(sources))
+	errs := make([]error, len(sources))
+	var docs []any
+	for i, source := range sources {
+		ids[i] = strconv.Itoa(source.PID)
+		if errs[i] = validateProduct(source); errs[i] == nil {
+			docs = append(docs, products.BuildProduct(source))
+		}
+	}
+	return write(ctx, &products.ProductStore, "index", ids, errs, docs, refresh)
+}
+
+// DeleteProducts 按PID删除产品
+func DeleteProducts(ctx context.Context, pids []int32, refresh string) (models.WriteResult, error) {
+	ids := make([]string, len(pids))
+	errs := make([]error, len(pids))
+	var docs []any
+	for i, pid := range pids {
+		ids[i] = strconv.Itoa(int(pid))
+		if pid < 1 {
+			errs[i] = fmt.Errorf("PID is error: %d", pid)
+			continue
+		}
+		docs = append(docs, ids[i])
+	}
+	return write(ctx, &products.ProductStore, "delete", ids, errs, docs, refresh)
+}
+
+// UpsertPrices 写入或覆盖价格, 文档由 prices.BuildStockPrice 生成, SPID 规则与价格作业一致
+func UpsertPrices(ctx context.Context, sources []model.PriceSource, refresh string) (models.WriteResult, error) {
+	ids := make([]string, len(sources))
+	errs := make([]error, len(sources))
+	var docs []any
+	for i, source := range sources {
+		ids[i] = prices.PriceSPID(source.DistributorType, source.DistributorID, source.PID, source.SID)
+		if errs[i] = validatePrice(source); errs[i] == nil {
+			docs = append(docs, prices.BuildStockPrice(source))
+		}
+	}
+	return write(ctx, &prices.PriceStore, "index", ids, errs, docs, refresh)
+}
+
+// DeletePrices 按SPID删除价格
+func DeletePrices(ctx context.Context, spids []string, refresh string) (models.WriteResult, error) {
+	ids := make([]string, len(spids))
+	errs := make([]error, len(spids))
+	var docs []any
+	for i, spid := range spids {
+		ids[i] = strings.TrimSpace(spid)
+		if len(ids[i]) == 0 {
+			errs[i] = fmt.Errorf("SPID is empty")
+			continue
+		}
+		docs = append(docs, ids[i])
+	}
+	return write(ctx, &prices.PriceStore, "delete", ids, errs, docs, refresh)
+}
+
+// write 批量写入有效的条目, 按请求顺序合并校验结果与写入结果
+func write(ctx context.Context, store *easyes.Store, opt string, ids []string, errs []error, docs []any, refresh string) (models.WriteResult, error) {
+	var result models.WriteResult
+	result.Total = int32(len(ids))
+
+	if len(ids) == 0 {
+		return result, errno.InvalidParam("documents is empty")
+	}
+	if len(ids) > maxWriteSize {
+		return result, errno.InvalidParam("documents size can not be greater than %d", maxWriteSize)
+	}
+	if len(refresh) == 0 {
+		refresh = Refresh
+	}
+	if !easyes.IsRefreshPolicy(refresh) {
+		return result, errno.InvalidParam("refresh policy is error: %s", refresh)
+	}
+
+	bulkResults, err := store.BulkWithResult(ctx, docs, opt, refresh)
+	if err != nil {
+		return result, err
+	}
+
+	next := 0
+	for i, id := range ids {
+		item := models.WriteItemResult{ID: id}
+		switch {
+		case errs[i] != nil:
+			item.Result = ResultInvalid
+			item.Error = errs[i].Error()
+		case next >= len(bulkResults):
+			item.Result = ResultError
+			item.Error = "missing bulk result"
+		default:
+			bulkResult := bulkResults[next]
+			next++
+			item.Result = bulkResult.Result
+			// 删除不存在的文档视为成功
+			if bulkResult.Status >= 300 && !(opt == "delete" && bulkResult.Status == 404) {
+				item.Result = ResultError
+				item.Error = bulkResult.Error
+			}
+		}
+
+		if item.Result == ResultInvalid || item.Result == ResultError {
+			result.Failed++
+		} else {
+			result.Succeeded++
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	return result, nil
+}
+
+// validateProduct 产品校验
+func validateProduct(source model.ProductSource) error {
+	if source.PID < 1 {
+		return fmt.Errorf("PID is error: %d", source.PID)
+	}
+	if len(strings.TrimSpace(source.ProductName)) == 0 {
+		return fmt.Errorf("ProductName is empty")
+	}
+	if source.BrandID < 0 || source.CategoryID < 0 || source.ParentID < 0 {
+		return fmt.Errorf("BrandID, CategoryID or ParentID is error")
+	}
+	return nil
+}
+
+// validatePrice 价格校验, 入驻分销商以SID为文档ID
+func validatePrice(source model.PriceSource) error {
+	if source.PID < 1 {
+		return fmt.Errorf("PID is error: %d", source.PID)
+	}
+	if source.DistributorID < 1 {
+		return fmt.Errorf("DistributorID is error: %d", source.DistributorID)
+	}
+	switch source.DistributorType {
+	case model.EsPriceJoinStart:
+		if source.SID < 1 {
+			return fmt.Errorf("SID is error: %d", source.SID)
+		}
+	case model.EsPriceCrawlStart:
+	default:
+		return fmt.Errorf("DistributorType is error: %d", source.DistributorType)
+	}
+	if source.StockNum < 0 || source.MOQ < 0 || source.Multiples < 0 {
+		return fmt.Errorf("StockNum, MOQ or Multiples is error")
+	}
+	for _, step := range source.StepPrices {
+		if step.Qty < 1 || step.Price < 0 {
+			return fmt.Errorf("StepPrice is error: %d %v", step.Qty, step.Price)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package bom
+
+import (
+	"context"
+	"easyms-es/errno"
+	"easyms-es/model"
+	"easyms-es/service/currency"
+	"easyms-es/service/models"
+	"easyms-es/service/prices"
+	"easyms-es/service/products"
+	"easyms-es/utility"
+	"strings"
+	"sync"
+)
+
+// 匹配方式
+const (
+	MatchExact  = "exact"
+	MatchPrefix = "prefix"
+	MatchFuzzy  = "fuzzy"
+	MatchNone   = "none"
+)
+
+const (
+	maxBomRows          = 500
+	defaultTopOffers    = 3
+	maxTopOffers        = 20
+	matchCandidateSize  = 10
+	minFuzzyConfidence  = 0.5
+	brandMismatchFactor = 0.9
+)
+
+// Workers 并发匹配的协程数量
+var Workers = 8
+
+// Match BOM批量匹配, 每行依次尝试完全匹配, 前缀匹配, 模糊匹配, 并给出请求数量下的最优报价
+// 单行失败不影响其他行, 错误记录在该行的 Error 中, 请求取消或超时时返回上下文错误
+func Match(ctx context.Context, param model.BomMatchParam) (models.BomMatchResult, error) {
+	var result models.BomMatchResult
+	result.TargetCurrency = param.TargetCurrency
+
+	if len(param.Rows) == 0 {
+		return result, nil
+	}
+	if len(param.Rows) > maxBomRows {
+		return result, errno.InvalidParam("the number of BOM rows exceeds the limit of %d", maxBomRows)
+	}
+	// 目标币种在匹配前校验一次, 避免每行报价时重复失败
+	if len(param.TargetCurrency) > 0 && !currency.IsSupported(param.TargetCurrency) {
+		return result, errno.InvalidParam("target currency is not supported: %s", param.TargetCurrency)
+	}
+
+	topOffers := int(param.TopOffers)
+	if topOffers <= 0 {
+		topOffers = defaultTopOffers
+	}
+	if topOffers > maxTopOffers {
+		topOffers = maxTopOffers
+	}
+
+	result.Lines = make([]models.BomLine, len(param.Rows))
+	lines := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < max(Workers, 1); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for line := range lines {
+				result.Lines[line] = matchRow(ctx, line, param.Rows[line], topOffers, param.TargetCurrency)
+			}
+		}()
+	}
+	// 请求取消或超时后不再分发剩余的行
+dispatch:
+	for line := range param.Rows {
+		select {
+		case lines <- line:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(lines)
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return result, err
+	}
+
+	result.Total = int32(len(result.Lines))
+	for _, line := range result.Lines {
+		if line.MatchType != MatchNone {
+			result.Matched++
+		}
+	}
+	return result, nil
+}
+
+// matchRow 匹配单行并报价
+func matchRow(ctx context.Context, line int, row model.BomRow, topOffers int, targetCurrency string) models.BomLine {
+	bomLine := models.BomLine{
+		Line:            line + 1,
+		Row:             row,
+		StandPartNumber: utility.ReplaceStandProductNameStr(row.PartNumber),
+		MatchType:       MatchNone,
+	}
+	if len(bomLine.StandPartNumber) == 0 {
+		bomLine.Error = "part number is empty"
+		return bomLine
+	}
+
+	product, matchType, confidence, err := matchProduct(ctx, bomLine.StandPartNumber, row.Manufacturer)
+	if err != nil {
+		bomLine.Error = err.Error()
+		return bomLine
+	}
+	if matchType == MatchNone {
+		return bomLine
+	}
+	bomLine.Product = product
+	bomLine.MatchType = matchType
+	bomLine.Confidence = confidence
+
+	quantity := row.Quantity
+	if quantity <= 0 {
+		quantity = 1
+	}
+	quotes, err := prices.Quote(ctx, model.QuoteParam{
+		PriceSearchParam: model.PriceSearchParam{PID: int32(product.PID), TargetCurrency: targetCurrency},
+		Quantity:         quantity,
+	})
+	if err != nil {
+		bomLine.Error = err.Error()
+		return bomLine
+	}
+	if len(quotes.Results) > topOffers {
+		quotes.Results = quotes.Results[:topOffers]
+	}
+	bomLine.Offers = quotes.Results
+	return bomLine
+}
+
+// matchProduct 依次进行完全, 前缀及模糊匹配, 返回置信度最高的产品
+func matchProduct(ctx context.Context, partNo string, manufacturer string) (model.Product, string, float64, error) {
+	var best model.Product
+	bestType, bestConfidence := MatchNone, 0.0
+
+	candidates, err := products.MatchProducts(ctx, partNo, manufacturer, matchCandidateSize, false)
+	if err != nil {
+		return best, bestType, bestConfidence, err
+	}
+	for _, p := range candidates {
+		name := utility.ReplaceStandProductNameStr(products.GetProductName(p))
+		matchType, confidence := MatchNone, 0.0
+		switch {
+		case name == partNo:
+			matchType, confidence = MatchExact, 1
+		case strings.HasPrefix(name, partNo):
+			// 型号缺少后缀(封装,包装等)
+			matchType, confidence = MatchPrefix, 0.9*float64(len(partNo))/float64(len(name))
+		case strings.HasPrefix(partNo, name):
+			// 型号多出后缀
+			matchType, confidence = MatchPrefix, 0.8*float64(len(name))/float64(len(partNo))
+		}
+		confidence = brandConfidence(confidence, manufacturer, p)
+		if confidence > bestConfidence {
+			best, bestType, bestConfidence = p, matchType, confidence
+		}
+	}
+	if bestType != MatchNone {
+		return best, bestType, bestConfidence, nil
+	}
+
+	candidates, err = products.MatchProducts(ctx, partNo, manufacturer, matchCandidateSize, true)
+	if err != nil {
+		return best, bestType, bestConfidence, err
+	}
+	for _, p := range candidates {
+		name := utility.ReplaceStandProductNameStr(products.GetProductName(p))
+		confidence := 0.7 * utility.Similarity(name, partNo)
+		if confidence < minFuzzyConfidence*0.7 {
+			continue
+		}
+		confidence = brandConfidence(confidence, manufacturer, p)
+		if confidence > bestConfidence {
+			best, bestType, bestConfidence = p, MatchFuzzy, confidence
+		}
+	}
+	return best, bestType, bestConfidence, nil
+}
+
+// brandConfidence 指定了品牌但与产品品牌不一致时降低置信度
+func brandConfidence(confidence float64, manufacturer string, p model.Product) float64 {
+	manufacturer = utility.ReplaceStandStr(manufacturer)
+	if len(manufacturer) == 0 || confidence == 0 {
+		return confidence
+	}
+	brand := utility.ReplaceStandStr(products.GetProductBrand(p))
+	if len(brand) > 0 && (strings.Contains(brand, manufacturer) || strings.Contains(manufacturer, brand)) {
+		return confidence
+	}
+	return confidence * brandMismatchFactor
+}
@@ -0,0 +1,198 @@
+package currency
+
+import (
+	"database/sql"
+	"easyms-es/utility"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Rate 汇率, Rate 为 1 单位该币种折合基准币种的数量(十进制字符串)
+type Rate struct {
+	Currency   string    `json:"Currency"`
+	Rate       string    `json:"Rate"`
+	UpdateTime time.Time `json:"UpdateTime"`
+}
+
+// SourceConfig 汇率来源, Source 为 file 时读取 File, 为 sqlserver 时读取 ConnString 下的 Table
+type SourceConfig struct {
+	Source     string
+	File       string
+	ConnString string
+	Table      string
+}
+
+// rateTable 汇率表, 整表替换保证读取的一致性
+type rateTable struct {
+	rates      map[string]*big.Rat
+	updateTime time.Time
+}
+
+var (
+	table     *rateTable
+	tableLock sync.RWMutex
+	source    SourceConfig
+)
+
+// DefaultScale 换算结果保留的小数位数
+var DefaultScale = 4
+
+// Init 设置汇率来源并加载汇率表
+func Init(c SourceConfig) error {
+	source = c
+	return Refresh()
+}
+
+// Refresh 从配置的来源重新加载汇率表, 加载失败时保留原有汇率
+func Refresh() error {
+	var rates []Rate
+	var err error
+	switch source.Source {
+	case "file":
+		rates, err = loadFromFile(source.File)
+	case "sqlserver":
+		rates, err = loadFromDB(source.ConnString, source.Table)
+	default:
+		return fmt.Errorf("currency source is not supported: %s", source.Source)
+	}
+	if err != nil {
+		return err
+	}
+	return setRates(rates)
+}
+
+// setRates 校验并替换汇率表
+func setRates(rates []Rate) error {
+	t := &rateTable{rates: make(map[string]*big.Rat)}
+	for _, rate := range rates {
+		value, err := utility.ParseDecimal(rate.Rate)
+		if err != nil {
+			return fmt.Errorf("currency %s rate is invalid: %s", rate.Currency, rate.Rate)
+		}
+		if value.Sign() <= 0 {
+			return fmt.Errorf("currency %s rate must be positive: %s", rate.Currency, rate.Rate)
+		}
+		t.rates[normalize(rate.Currency)] = value
+		if rate.UpdateTime.After(t.updateTime) {
+			t.updateTime = rate.UpdateTime
+		}
+	}
+	if len(t.rates) == 0 {
+		return fmt.Errorf("currency rate table is empty")
+	}
+
+	tableLock.Lock()
+	table = t
+	tableLock.Unlock()
+	return nil
+}
+
+// loadFromFile 读取json格式的汇率文件 [{"Currency":"USD","Rate":"7.1","UpdateTime":"..."}]
+func loadFromFile(file string) ([]Rate, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var rates []Rate
+	if err := json.Unmarshal(data, &rates); err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+// loadFromDB 读取sql server汇率表, 表需包含 Currency, Rate, UpdateTime 字段
+// sqlserver 驱动由引用方注册(api 中为日志模块), 避免与任务程序的驱动重复注册
+func loadFromDB(connString string, tableName string) ([]Rate, error) {
+	conn, err := sql.Open("sqlserver", connString)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	// 表名来自配置文件, 不接受外部输入
+	rows, err := conn.Query(fmt.Sprintf("SELECT Currency, CAST(Rate AS VARCHAR(50)), UpdateTime FROM %s", tableName))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var rates []Rate
+	for rows.Next() {
+		var rate Rate
+		if err := rows.Scan(&rate.Currency, &rate.Rate, &rate.UpdateTime); err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+	return rates, rows.Err()
+}
+
+// Convert 将金额从 from 币种换算为 to 币种, 返回换算结果及汇率时间
+func Convert(amount string, from string, to string) (string, time.Time, error) {
+	tableLock.RLock()
+	t := table
+	tableLock.RUnlock()
+	if t == nil {
+		return "", time.Time{}, fmt.Errorf("currency rate table is not loaded")
+	}
+
+	value, err := utility.ParseDecimal(amount)
+	if err != nil {
+		return "", t.updateTime, err
+	}
+	fromRate, ok := t.rates[normalize(from)]
+	if !ok {
+		return "", t.updateTime, fmt.Errorf("currency rate not found: %s", from)
+	}
+	toRate, ok := t.rates[normalize(to)]
+	if !ok {
+		return "", t.updateTime, fmt.Errorf("currency rate not found: %s", to)
+	}
+
+	value.Mul(value, fromRate)
+	value.Quo(value, toRate)
+	return utility.FormatDecimal(value, DefaultScale), t.updateTime, nil
+}
+
+// IsSupported 判断币种是否在汇率表中
+func IsSupported(currency string) bool {
+	tableLock.RLock()
+	defer tableLock.RUnlock()
+	if table == nil {
+		return false
+	}
+	_, ok := table.rates[normalize(currency)]
+	return ok
+}
+
+// normalize 币种统一大写
+func normalize(currency string) string {
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
+
+// StartRefresh 按cron表达式定时刷新汇率表, 返回的调度器由调用方负责停止
+func StartRefresh(spec string) (*cron.Cron, error) {
+	c := cron.New()
+	_, err := c.AddFunc(spec, func() {
+		if err := Refresh(); err != nil {
+			log.Printf("failed to refresh currency rates: %v", err)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.Start()
+	return c, nil
+}
@@ -0,0 +1,88 @@
+package currency
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConvert(t *testing.T) {
+	updateTime := time.Date(2024, 8, 1, 8, 0, 0, 0, time.UTC)
+	if err := setRates([]Rate{
+		{Currency: "CNY", Rate: "1", UpdateTime: updateTime.Add(-time.Hour)},
+		{Currency: "USD", Rate: "7.1", UpdateTime: updateTime},
+		{Currency: "eur", Rate: "7.75", UpdateTime: updateTime.Add(-time.Minute)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		amount  string
+		from    string
+		to      string
+		want    string
+		wantErr bool
+	}{
+		{name: "to base", amount: "2.5", from: "USD", to: "CNY", want: "17.7500"},
+		{name: "from base rounds", amount: "1", from: "CNY", to: "USD", want: "0.1408"},
+		{name: "cross rate", amount: "100", from: "EUR", to: "USD", want: "109.1549"},
+		{name: "case and spaces", amount: "1", from: " usd ", to: "cny", want: "7.1000"},
+		{name: "same currency", amount: "0.00005", from: "USD", to: "USD", want: "0.0001"},
+		{name: "negative", amount: "-2.5", from: "USD", to: "CNY", want: "-17.7500"},
+		{name: "missing source rate", amount: "1", from: "JPY", to: "CNY", wantErr: true},
+		{name: "missing target rate", amount: "1", from: "CNY", to: "JPY", wantErr: true},
+		{name: "invalid amount", amount: "1,5", from: "USD", to: "CNY", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, rateTime, err := Convert(tt.amount, tt.from, tt.to)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Convert() = %s, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("Convert(%s, %s, %s) = %s, want %s", tt.amount, tt.from, tt.to, got, tt.want)
+			}
+			if !rateTime.Equal(updateTime) {
+				t.Fatalf("Convert() rate time = %v, want %v", rateTime, updateTime)
+			}
+		})
+	}
+
+	if !IsSupported("eur") || IsSupported("JPY") {
+		t.Fatal("IsSupported() does not match the rate table")
+	}
+}
+
+func TestSetRates(t *testing.T) {
+	tests := []struct {
+		name  string
+		rates []Rate
+	}{
+		{name: "empty", rates: nil},
+		{name: "invalid rate", rates: []Rate{{Currency: "USD", Rate: "7,1"}}},
+		{name: "zero rate", rates: []Rate{{Currency: "USD", Rate: "0"}}},
+		{name: "negative rate", rates: []Rate{{Currency: "USD", Rate: "-7.1"}}},
+	}
+
+	if err := setRates([]Rate{{Currency: "USD", Rate: "7.1"}}); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := setRates(tt.rates); err == nil {
+				t.Fatal("setRates() want error")
+			}
+			// 加载失败时保留原有汇率
+			if !IsSupported("USD") {
+				t.Fatal("setRates() replaced the rate table on error")
+			}
+		})
+	}
+}
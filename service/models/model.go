@@ -2,6 +2,7 @@ package models
 
 import (
 	"easyms-es/model"
+	"time"
 )
 
 type SearchResult struct {
@@ -14,13 +15,26 @@ type SearchResult struct {
 type SearchProductResult struct {
 	SearchResult
 	Results             []model.Product `json:"results,omitempty"`
+	Highlights          map[int]string  `json:"highlights,omitempty"` // PID:高亮型号
 	IsReSearch          bool
 	SimilarProductNames []string
 }
 
 type SearchPriceResult struct {
 	SearchResult
-	Results []model.StockPrice `json:"results,omitempty"`
+	Results        []model.StockPrice        `json:"results,omitempty"`
+	TargetCurrency string                    `json:"targetCurrency,omitempty"`
+	RateTime       time.Time                 `json:"rateTime,omitempty"`
+	Converted      map[string]ConvertedPrice `json:"converted,omitempty"` // SPID:换算后的价格
+}
+
+// ConvertedPrice 换算为目标币种后的阶梯价格, 十进制字符串
+type ConvertedPrice struct {
+	Price1 string `json:"price1,omitempty"`
+	Price2 string `json:"price2,omitempty"`
+	Price3 string `json:"price3,omitempty"`
+	Price4 string `json:"price4,omitempty"`
+	Price5 string `json:"price5,omitempty"`
 }
 
 type CollapsePrice struct {
@@ -33,3 +47,108 @@ type CollapseSearchPriceResult struct {
 	SearchResult
 	Results []CollapsePrice `json:"results,omitempty"`
 }
+
+type FacetBucket struct {
+	Key      string        `json:"key"`
+	Name     string        `json:"name,omitempty"`
+	Group    string        `json:"group,omitempty"`
+	Count    int           `json:"count"`
+	Children []FacetBucket `json:"children,omitempty"`
+}
+
+type SearchFacetResult struct {
+	Categories      []FacetBucket `json:"categories,omitempty"`
+	Brands          []FacetBucket `json:"brands,omitempty"`
+	Distributors    []FacetBucket `json:"distributors,omitempty"`
+	AttributeNames  []FacetBucket `json:"attributeNames,omitempty"`
+	AttributeValues []FacetBucket `json:"attributeValues,omitempty"`
+	IsCache         bool          `json:"isCache,omitempty"`
+}
+
+type ProductIndexCount struct {
+	ProductNameIndex int    `json:"productNameIndex"`
+	Initial          string `json:"initial"`
+	Count            int    `json:"count"`
+}
+
+type SearchProductIndexResult struct {
+	SearchResult
+	LastPID int32               `json:"lastPid,omitempty"`
+	Results []model.Product     `json:"results,omitempty"`
+	Indexes []ProductIndexCount `json:"indexes,omitempty"`
+}
+
+type ExportProductChunk struct {
+	Total       int32           `json:"total,omitempty"`
+	Results     []model.Product `json:"results,omitempty"`
+	ResumeToken string          `json:"resumeToken,omitempty"`
+}
+
+type ExportPriceChunk struct {
+	Total       int32              `json:"total,omitempty"`
+	Results     []model.StockPrice `json:"results,omitempty"`
+	ResumeToken string             `json:"resumeToken,omitempty"`
+}
+
+type AsyncSearchResult struct {
+	ID             string              `json:"id"`
+	IsRunning      bool                `json:"isRunning,omitempty"`
+	IsPartial      bool                `json:"isPartial,omitempty"`
+	StartTime      int64               `json:"startTime,omitempty"`
+	ExpirationTime int64               `json:"expirationTime,omitempty"`
+	Products       SearchProductResult `json:"products"`
+	Facets         *SearchFacetResult  `json:"facets,omitempty"`
+}
+
+// PriceQuote 单个报价, OrderQty 为满足起订量及倍数后的实际订购数量
+type PriceQuote struct {
+	Price                  model.StockPrice `json:"price"`
+	OrderQty               int              `json:"orderQty"`
+	BreakQty               int              `json:"breakQty"`
+	UnitPrice              string           `json:"unitPrice"`
+	ExtendedPrice          string           `json:"extendedPrice"`
+	ConvertedUnitPrice     string           `json:"convertedUnitPrice,omitempty"`
+	ConvertedExtendedPrice string           `json:"convertedExtendedPrice,omitempty"`
+	StockEnough            bool             `json:"stockEnough"`
+}
+
+type QuotePriceResult struct {
+	PID            int32        `json:"pid"`
+	Quantity       int32        `json:"quantity"`
+	TargetCurrency string       `json:"targetCurrency,omitempty"`
+	RateTime       time.Time    `json:"rateTime,omitempty"`
+	Results        []PriceQuote `json:"results,omitempty"`
+}
+
+// BomLine BOM单行匹配结果, MatchType 为 exact/prefix/fuzzy/none
+type BomLine struct {
+	Line            int           `json:"line"`
+	Row             model.BomRow  `json:"row"`
+	StandPartNumber string        `json:"standPartNumber"`
+	MatchType       string        `json:"matchType"`
+	Confidence      float64       `json:"confidence"`
+	Product         model.Product `json:"product"`
+	Offers          []PriceQuote  `json:"offers,omitempty"`
+	Error           string        `json:"error,omitempty"`
+}
+
+type BomMatchResult struct {
+	Total          int32     `json:"total"`
+	Matched        int32     `json:"matched"`
+	TargetCurrency string    `json:"targetCurrency,omitempty"`
+	Lines          []BomLine `json:"lines,omitempty"`
+}
+
+// WriteItemResult 单条写入结果, Result 为 created/updated/deleted/not_found/invalid/error
+type WriteItemResult struct {
+	ID     string `json:"id"`
+	Result string `json:"result"`
+	Error  string `json:"error,omitempty"`
+}
+
+type WriteResult struct {
+	Total     int32             `json:"total"`
+	Succeeded int32             `json:"succeeded"`
+	Failed    int32             `json:"failed"`
+	Items     []WriteItemResult `json:"items,omitempty"`
+}
@@ -1,15 +1,153 @@
 package prices
 
 import (
-	"fmt"
+	"easyms-es/errno"
+	"easyms-es/model"
+	"easyms-es/utility"
+	"encoding/json"
 	"strings"
 )
 
-func BuildSingleQuery(pid int, from int, size int) string {
-	orderScript = strings.ReplaceAll(orderScript, "\r", "")
-	orderScript = strings.ReplaceAll(orderScript, "\n", "")
-	orderScript = strings.ReplaceAll(orderScript, "\t", "")
-	return fmt.Sprintf(SingleQueryStr, pid, orderScript, from, size)
+// BuildSingleQuery 单产品价格查询, 支持库存,授权,分销商类型等过滤及多种排序
+func BuildSingleQuery(param model.PriceSearchParam) (string, error) {
+	filter := []any{
+		map[string]any{"term": map[string]any{"PID": param.PID}},
+	}
+	if param.MinStock > 0 {
+		filter = append(filter, map[string]any{"range": map[string]any{"StockNum": map[string]any{"gte": param.MinStock}}})
+	} else if param.InStock {
+		filter = append(filter, map[string]any{"range": map[string]any{"StockNum": map[string]any{"gt": 0}}})
+	}
+	if param.AuthorizedOnly {
+		filter = append(filter, map[string]any{"term": map[string]any{"ISAuthorizeddealer": "true"}})
+	}
+	if param.DistributorType > 0 {
+		filter = append(filter, map[string]any{"term": map[string]any{"DistributorType": param.DistributorType}})
+	}
+	if !utility.NullArrayIntCheck(param.DistributorIDs) {
+		filter = append(filter, map[string]any{"terms": map[string]any{"DistributorID": param.DistributorIDs}})
+	}
+	if len(param.Currencies) > 0 {
+		filter = append(filter, map[string]any{"terms": map[string]any{"Currency": param.Currencies}})
+	}
+
+	var sorts []any
+	switch param.SortType {
+	case model.PriceSortLowestPrice:
+		field, err := priceTierField(param.PriceTier)
+		if err != nil {
+			return "", err
+		}
+		sorts = append(sorts, map[string]any{
+			"_script": map[string]any{
+				"type": "number",
+				"script": map[string]any{
+					"lang":   "painless",
+					"source": getTierPriceScript(),
+					"params": map[string]any{"field": field},
+				},
+				"order": "asc",
+			},
+		})
+	case model.PriceSortHighestStock:
+		sorts = append(sorts, map[string]any{"StockNum": map[string]any{"order": "desc"}})
+	case model.PriceSortFreshness:
+	default:
+		return "", errno.InvalidParam("price sort type is error: %d", param.SortType)
+	}
+	sorts = append(sorts, freshnessSort())
+
+	query := map[string]any{
+		"query": map[string]any{
+			"bool": map[string]any{"filter": filter},
+		},
+		"sort": sorts,
+		"from": param.From,
+		"size": param.Size,
+	}
+
+	body, err := json.Marshal(query)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// priceTierField 价格阶梯对应的索引字段
+func priceTierField(tier int32) (string, error) {
+	fields := []string{"StepPrice1", "StepPrice2", "StepPrice100", "StepPrice1k", "StepPrice1w"}
+	if tier == 0 {
+		tier = 1
+	}
+	if tier < 1 || int(tier) > len(fields) {
+		return "", errno.InvalidParam("price tier is error: %d", tier)
+	}
+	return fields[tier-1], nil
+}
+
+// freshnessSort 按更新时间及排序权重的脚本排序
+func freshnessSort() map[string]any {
+	return map[string]any{
+		"_script": map[string]any{
+			"type": "number",
+			"script": map[string]any{
+				"lang":   "painless",
+				"source": getOrderScript(),
+				"params": map[string]any{"factor": 1.1},
+			},
+			"order": "desc",
+		},
+	}
+}
+
+// getOrderScript 去除换行及缩进的排序脚本
+func getOrderScript() string {
+	return compactScript(orderScript)
+}
+
+// getTierPriceScript 去除换行及缩进的阶梯价格脚本
+func getTierPriceScript() string {
+	return compactScript(tierPriceScript)
+}
+
+// compactScript 去除脚本中的换行及制表符
+func compactScript(script string) string {
+	script = strings.ReplaceAll(script, "\r", "")
+	script = strings.ReplaceAll(script, "\n", "")
+	script = strings.ReplaceAll(script, "\t", "")
+	return script
+}
+
+// BuildCollapseQuery 多产品价格折叠查询, 按PID折叠, inner_hits 按排序脚本取每个产品的前 topSize 条价格
+func BuildCollapseQuery(pids []int32, topSize int) (string, error) {
+	scriptSort := freshnessSort()
+
+	query := map[string]any{
+		"query": map[string]any{
+			"bool": map[string]any{
+				"filter": []any{
+					map[string]any{"terms": map[string]any{"PID": pids}},
+				},
+			},
+		},
+		"collapse": map[string]any{
+			"field": "PID",
+			"inner_hits": map[string]any{
+				"name": collapseName,
+				"size": topSize,
+				"sort": []any{scriptSort},
+			},
+		},
+		"sort": []any{scriptSort},
+		"from": 0,
+		"size": len(pids),
+	}
+
+	body, err := json.Marshal(query)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
 }
 
 var orderScript = `long currentTime = new Date().getTime();
@@ -28,31 +166,17 @@ var orderScript = `long currentTime = new Date().getTime();
               return 1 + doc['Sort'].value;
             }`
 
-const SingleQueryStr = `{
-"query": {
-    "bool": {
-      "filter": [
-        {
-          "term": {
-            "PID": "%d"
-          }
-        }
-      ]
-    }
-  },
-  "sort": {
-    "_script":{
-      "type": "number",
-      "script":{
-        "lang": "painless",
-        "source": "%s",
-        "params":{
-          "factor":1.1
-        }
-      },
-      "order":"desc"
-    }
-  },
-  "from": %d,
-  "size": %d
-}`
+// 阶梯价格为字符串, 无价格或无法解析时排在最后
+var tierPriceScript = `if (doc[params.field].size() == 0) {
+              return Double.MAX_VALUE;
+            }
+            String value = doc[params.field].value;
+            if (value == null || value.isEmpty()) {
+              return Double.MAX_VALUE;
+            }
+            try {
+              double price = Double.parseDouble(value);
+              return price > 0 ? price : Double.MAX_VALUE;
+            } catch (NumberFormatException e) {
+              return Double.MAX_VALUE;
+            }`
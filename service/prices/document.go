@@ -0,0 +1,122 @@
+package prices
+
+import (
+	"easyms-es/model"
+	"easyms-es/utility"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// PriceSPID 价格文档ID, 非入驻分销商为 {DistributorType}-{DistributorID}-{PID}, 入驻分销商为价格表SID
+func PriceSPID(distributorType int, distributorID int, pid int, sid int) string {
+	if distributorType == model.EsPriceCrawlStart {
+		return fmt.Sprintf("%d-%d-%d", distributorType, distributorID, pid)
+	}
+	return fmt.Sprintf("%d", sid)
+}
+
+// BuildStockPrice 由价格源数据生成索引文档, 计算SPID、阶梯价格及排序值
+func BuildStockPrice(source model.PriceSource) model.StockPrice {
+	stockPrice := model.StockPrice{
+		SPID:            PriceSPID(source.DistributorType, source.DistributorID, source.PID, source.SID),
+		DistributorType: source.DistributorType,
+		DistributorID:   source.DistributorID,
+		PID:             source.PID,
+		StockNum:        source.StockNum,
+		Currency:        source.Currency,
+		UpdateTime:      source.UpdateTime,
+		MOQ:             source.MOQ,
+		Multiples:       source.Multiples,
+	}
+	if len(source.ProductName) > 0 {
+		stockPrice.ProductExt = fmt.Sprintf("{%s},{%s}", utility.RemoveFlagStr(source.ProductName), utility.RemoveFlagStr(source.Brand))
+	}
+	if len(source.Distributor) > 0 {
+		stockPrice.DistributorExt = fmt.Sprintf("{%s},{%s}", utility.RemoveFlagStr(source.Distributor), source.DistributorProductUrl)
+	}
+
+	setStepPrices(&stockPrice, source.StepPrices)
+	stockPrice.Sort = PriceSort(stockPrice)
+
+	return stockPrice
+}
+
+// setStepPrices 阶梯价格处理, 保留原始阶梯并归并到 1/10/100/1k/1w 五档
+func setStepPrices(stockPrice *model.StockPrice, steps []model.StepPrice) {
+	if len(steps) == 0 {
+		return
+	}
+
+	// 保留原始阶梯价格(数量正序), 用于按数量报价
+	breaks := slices.Clone(steps)
+	slices.SortStableFunc(breaks, func(a, b model.StepPrice) int {
+		return a.Qty - b.Qty
+	})
+	for _, step := range breaks {
+		if step.Qty > 0 && step.Price > 0 {
+			stockPrice.PriceBreaks = append(stockPrice.PriceBreaks, model.PriceBreak{
+				Qty:   step.Qty,
+				Price: strconv.FormatFloat(step.Price, 'f', 4, 64),
+			})
+		}
+	}
+
+	// 对价格基于qty做倒序便于便利赋值
+	priceStep := slices.Clone(steps)
+	slices.SortStableFunc(priceStep, func(a, b model.StepPrice) int {
+		return b.Qty - a.Qty
+	})
+	// 为了获取最准确的价格,这里一定要注意已经赋值后不再重复赋值
+	for _, step := range priceStep {
+		switch qty := step.Qty; {
+		case qty == 1:
+			if step.Price > 0 && len(stockPrice.StepPrice1) == 0 {
+				stockPrice.StepPrice1 = strconv.FormatFloat(step.Price, 'f', 4, 32)
+			}
+		case qty <= 10:
+			if step.Price > 0 && len(stockPrice.StepPrice2) == 0 {
+				stockPrice.StepPrice2 = strconv.FormatFloat(step.Price, 'f', 4, 32)
+			}
+		case qty <= 100:
+			if step.Price > 0 && len(stockPrice.StepPrice3) == 0 {
+				stockPrice.StepPrice3 = strconv.FormatFloat(step.Price, 'f', 4, 32)
+			}
+		case qty <= 1000:
+			if step.Price > 0 && len(stockPrice.StepPrice4) == 0 {
+				stockPrice.StepPrice4 = strconv.FormatFloat(step.Price, 'f', 4, 32)
+			}
+		case qty <= 10000:
+			if step.Price > 0 && len(stockPrice.StepPrice5) == 0 {
+				stockPrice.StepPrice5 = strconv.FormatFloat(step.Price, 'f', 4, 32)
+			}
+		}
+	}
+	// 对价格的回写,这里可以根据运营需求做出调整
+	if len(stockPrice.StepPrice1) > 0 && len(stockPrice.StepPrice2) == 0 {
+		stockPrice.StepPrice2 = stockPrice.StepPrice1
+	}
+	if len(stockPrice.StepPrice2) > 0 && len(stockPrice.StepPrice3) == 0 {
+		stockPrice.StepPrice3 = stockPrice.StepPrice2
+	}
+	if len(stockPrice.StepPrice3) > 0 && len(stockPrice.StepPrice4) == 0 {
+		stockPrice.StepPrice4 = stockPrice.StepPrice3
+	}
+	if len(stockPrice.StepPrice4) > 0 && len(stockPrice.StepPrice5) == 0 {
+		stockPrice.StepPrice5 = stockPrice.StepPrice4
+	}
+}
+
+// PriceSort 价格排序（按照业务规则定义）, 有库存及每档有价格各加10
+func PriceSort(stockPrice model.StockPrice) int {
+	sort := 0
+	if stockPrice.StockNum > 0 {
+		sort += 10
+	}
+	for _, price := range []string{stockPrice.StepPrice1, stockPrice.StepPrice2, stockPrice.StepPrice3, stockPrice.StepPrice4, stockPrice.StepPrice5} {
+		if len(price) > 0 {
+			sort += 10
+		}
+	}
+	return sort
+}
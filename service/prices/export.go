@@ -0,0 +1,99 @@
+package prices
+
+import (
+	"context"
+	"easyms-es/easyes"
+	"easyms-es/model"
+	"easyms-es/service/models"
+	"easyms-es/utility"
+	"encoding/json"
+)
+
+const (
+	exportKeepAlive        = "1m"
+	defaultExportChunkSize = 500
+	maxExportChunkSize     = 2000
+)
+
+// Export 价格导出, 通过 PIT + search_after 按 PID,SPID 顺序遍历, 每页回调一次
+// 回调阻塞时不会读取下一页, 续传令牌为当前页最后一条的 PID,SPID
+func Export(ctx context.Context, param model.ExportPriceParam, fn func(chunk models.ExportPriceChunk) error) error {
+	query, err := buildExportQuery(param)
+	if err != nil {
+		return err
+	}
+
+	return PriceStore.WalkPointInTime(ctx, query, exportKeepAlive, func(res *easyes.SearchResponse) error {
+		var chunk models.ExportPriceChunk
+		chunk.Total = int32(res.Hits.Total.Value)
+		for _, hit := range res.Hits.Hits {
+			var h model.StockPrice
+			if err := json.Unmarshal(hit.Source, &h); err != nil {
+				return err
+			}
+			chunk.Results = append(chunk.Results, h)
+		}
+
+		last := res.Hits.Hits[len(res.Hits.Hits)-1].Sort
+		if len(last) > 1 {
+			token, err := utility.EncodeResumeToken(last[:2])
+			if err != nil {
+				return err
+			}
+			chunk.ResumeToken = token
+		}
+		return fn(chunk)
+	})
+}
+
+// buildExportQuery 构建导出DSL, 续传时以 (PID,SPID) 范围过滤代替 search_after, 令牌可跨PIT使用
+func buildExportQuery(param model.ExportPriceParam) (map[string]any, error) {
+	var filter []any
+	if param.BrandID > 0 {
+		filter = append(filter, map[string]any{"term": map[string]any{"BrandID": param.BrandID}})
+	}
+	if param.DistributorID > 0 {
+		filter = append(filter, map[string]any{"term": map[string]any{"DistributorID": param.DistributorID}})
+	}
+	if len(param.ResumeToken) > 0 {
+		values, err := utility.DecodeResumeToken(param.ResumeToken, 2)
+		if err != nil {
+			return nil, err
+		}
+		filter = append(filter, map[string]any{
+			"bool": map[string]any{
+				"should": []any{
+					map[string]any{"range": map[string]any{"PID": map[string]any{"gt": values[0]}}},
+					map[string]any{
+						"bool": map[string]any{
+							"filter": []any{
+								map[string]any{"term": map[string]any{"PID": values[0]}},
+								map[string]any{"range": map[string]any{"SPID": map[string]any{"gt": values[1]}}},
+							},
+						},
+					},
+				},
+				"minimum_should_match": 1,
+			},
+		})
+	}
+
+	size := param.ChunkSize
+	if size <= 0 {
+		size = defaultExportChunkSize
+	}
+	if size > maxExportChunkSize {
+		size = maxExportChunkSize
+	}
+
+	return map[string]any{
+		"query": map[string]any{
+			"bool": map[string]any{"filter": filter},
+		},
+		"sort": []any{
+			map[string]any{"PID": map[string]any{"order": "asc"}},
+			map[string]any{"SPID": map[string]any{"order": "asc"}},
+		},
+		"size": size,
+	}, nil
+}
@@ -0,0 +1,185 @@
+package prices
+
+import (
+	"context"
+	"easyms-es/errno"
+	"easyms-es/model"
+	"easyms-es/service/currency"
+	"easyms-es/service/models"
+	"easyms-es/utility"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	maxQuoteOffers  = 200
+	quotePriceScale = 4
+)
+
+// 旧数据没有原始阶梯时, 五档价格对应的起始数量
+var stepPriceQty = []int{1, 10, 100, 1000, 10000}
+
+// Quote 按数量报价, 计算每个分销商满足起订量及倍数后的单价和总价, 按总价由低到高排序, 见 rankQuotes
+func Quote(ctx context.Context, param model.QuoteParam) (models.QuotePriceResult, error) {
+	var result models.QuotePriceResult
+	result.PID = param.PID
+	result.Quantity = param.Quantity
+	result.TargetCurrency = param.TargetCurrency
+
+	if param.Quantity <= 0 {
+		return result, errno.InvalidParam("quote quantity must be positive")
+	}
+	if len(param.TargetCurrency) > 0 && !currency.IsSupported(param.TargetCurrency) {
+		return result, errno.InvalidParam("target currency is not supported: %s", param.TargetCurrency)
+	}
+
+	searchParam := param.PriceSearchParam
+	searchParam.SortType = model.PriceSortFreshness
+	searchParam.From = 0
+	searchParam.Size = maxQuoteOffers
+	query, err := BuildSingleQuery(searchParam)
+	if err != nil {
+		return result, err
+	}
+	res, err := PriceStore.Search(ctx, query)
+	if err != nil {
+		return result, err
+	}
+	var prices models.SearchPriceResult
+	if err := ResponseToStockPrice(*res, &prices); err != nil {
+		return result, err
+	}
+
+	result.Results, result.RateTime = rankQuotes(prices.Results, int(param.Quantity), param.TargetCurrency)
+
+	return result, nil
+}
+
+// rankQuotes 计算各分销商的报价并按总价由低到高排序, 同价时保持原有顺序, 返回换算使用的汇率时间
+// 指定目标币种时按换算后的总价排序, 未指定且币种不一致时按第一个有汇率的报价币种换算后排序, 无法换算的报价排在最后
+func rankQuotes(prices []model.StockPrice, quantity int, targetCurrency string) ([]models.PriceQuote, time.Time) {
+	type rankedQuote struct {
+		quote models.PriceQuote
+		value *big.Rat
+	}
+	var quotes []rankedQuote
+	var rateTime time.Time
+	currencies := make(map[string]bool)
+	for _, price := range prices {
+		quote, extended, ok := quotePrice(price, quantity)
+		if !ok {
+			continue
+		}
+		currencies[strings.ToUpper(price.Currency)] = true
+
+		rank := extended
+		if len(targetCurrency) > 0 {
+			rank = nil
+			unit, _, err := currency.Convert(quote.UnitPrice, price.Currency, targetCurrency)
+			if err == nil {
+				quote.ConvertedUnitPrice = unit
+			}
+			total, updateTime, err := currency.Convert(quote.ExtendedPrice, price.Currency, targetCurrency)
+			if err == nil {
+				quote.ConvertedExtendedPrice = total
+				rateTime = updateTime
+				rank, _ = utility.ParseDecimal(total)
+			}
+		}
+		quotes = append(quotes, rankedQuote{quote: quote, value: rank})
+	}
+
+	// 未指定目标币种且报价币种不一致时, 换算为同一币种后排序, 避免直接比较不同币种的金额
+	if len(targetCurrency) == 0 && len(currencies) > 1 {
+		var rankCurrency string
+		for _, q := range quotes {
+			if currency.IsSupported(q.quote.Price.Currency) {
+				rankCurrency = q.quote.Price.Currency
+				break
+			}
+		}
+		for i := range quotes {
+			total, _, err := currency.Convert(quotes[i].quote.ExtendedPrice, quotes[i].quote.Price.Currency, rankCurrency)
+			if err != nil {
+				quotes[i].value = nil
+				continue
+			}
+			quotes[i].value, _ = utility.ParseDecimal(total)
+		}
+	}
+
+	sort.SliceStable(quotes, func(i, j int) bool {
+		if quotes[i].value == nil || quotes[j].value == nil {
+			return quotes[j].value == nil && quotes[i].value != nil
+		}
+		return quotes[i].value.Cmp(quotes[j].value) < 0
+	})
+	var results []models.PriceQuote
+	for _, q := range quotes {
+		results = append(results, q.quote)
+	}
+	return results, rateTime
+}
+
+// quotePrice 计算单个分销商的报价, 没有可用价格时返回 false
+func quotePrice(price model.StockPrice, quantity int) (models.PriceQuote, *big.Rat, bool) {
+	quote := models.PriceQuote{Price: price}
+
+	breaks := priceBreaks(price)
+	if len(breaks) == 0 {
+		return quote, nil, false
+	}
+
+	// 起订量未设置时以最小阶梯数量为准
+	moq := price.MOQ
+	if moq <= 0 {
+		moq = breaks[0].Qty
+	}
+	orderQty := quantity
+	if orderQty < moq {
+		orderQty = moq
+	}
+	if price.Multiples > 1 && orderQty%price.Multiples != 0 {
+		orderQty = (orderQty/price.Multiples + 1) * price.Multiples
+	}
+
+	// 取数量不超过订购数量的最大阶梯, 订购数量小于所有阶梯时取第一档
+	unitBreak := breaks[0]
+	for _, b := range breaks {
+		if b.Qty <= orderQty {
+			unitBreak = b
+		}
+	}
+	unit, err := utility.ParseDecimal(unitBreak.Price)
+	if err != nil || unit.Sign() <= 0 {
+		return quote, nil, false
+	}
+	extended := new(big.Rat).Mul(unit, new(big.Rat).SetInt64(int64(orderQty)))
+
+	quote.OrderQty = orderQty
+	quote.BreakQty = unitBreak.Qty
+	quote.UnitPrice = utility.FormatDecimal(unit, quotePriceScale)
+	quote.ExtendedPrice = utility.FormatDecimal(extended, quotePriceScale)
+	quote.StockEnough = price.StockNum >= orderQty
+	return quote, extended, true
+}
+
+// priceBreaks 报价使用的阶梯, 优先使用原始阶梯, 否则由五档价格推算, 数量正序
+func priceBreaks(price model.StockPrice) []model.PriceBreak {
+	var breaks []model.PriceBreak
+	if len(price.PriceBreaks) > 0 {
+		breaks = append(breaks, price.PriceBreaks...)
+	} else {
+		for i, stepPrice := range []string{price.StepPrice1, price.StepPrice2, price.StepPrice3, price.StepPrice4, price.StepPrice5} {
+			if len(stepPrice) > 0 {
+				breaks = append(breaks, model.PriceBreak{Qty: stepPriceQty[i], Price: stepPrice})
+			}
+		}
+	}
+	sort.SliceStable(breaks, func(i, j int) bool {
+		return breaks[i].Qty < breaks[j].Qty
+	})
+	return breaks
+}
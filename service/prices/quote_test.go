@@ -0,0 +1,243 @@
+package prices
+
+import (
+	"easyms-es/model"
+	"easyms-es/service/currency"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func breaks(pairs ...any) []model.PriceBreak {
+	var result []model.PriceBreak
+	for i := 0; i+1 < len(pairs); i += 2 {
+		result = append(result, model.PriceBreak{Qty: pairs[i].(int), Price: pairs[i+1].(string)})
+	}
+	return result
+}
+
+func TestQuotePrice(t *testing.T) {
+	tiers := breaks(1, "1.00", 10, "0.80", 100, "0.50")
+
+	tests := []struct {
+		name         string
+		price        model.StockPrice
+		quantity     int
+		wantOK       bool
+		wantOrderQty int
+		wantBreakQty int
+		wantUnit     string
+		wantExtended string
+		wantEnough   bool
+	}{
+		{
+			name:     "first break",
+			price:    model.StockPrice{PriceBreaks: tiers, StockNum: 100},
+			quantity: 5, wantOK: true, wantOrderQty: 5, wantBreakQty: 1, wantUnit: "1.0000", wantExtended: "5.0000", wantEnough: true,
+		},
+		{
+			name:     "exact break quantity",
+			price:    model.StockPrice{PriceBreaks: tiers, StockNum: 100},
+			quantity: 10, wantOK: true, wantOrderQty: 10, wantBreakQty: 10, wantUnit: "0.8000", wantExtended: "8.0000", wantEnough: true,
+		},
+		{
+			name:     "moq greater than quantity",
+			price:    model.StockPrice{PriceBreaks: tiers, MOQ: 50, StockNum: 50},
+			quantity: 5, wantOK: true, wantOrderQty: 50, wantBreakQty: 10, wantUnit: "0.8000", wantExtended: "40.0000", wantEnough: true,
+		},
+		{
+			name:     "quantity not a multiple",
+			price:    model.StockPrice{PriceBreaks: tiers, Multiples: 25, StockNum: 49},
+			quantity: 30, wantOK: true, wantOrderQty: 50, wantBreakQty: 10, wantUnit: "0.8000", wantExtended: "40.0000",
+		},
+		{
+			name:     "moq rounded up to multiple",
+			price:    model.StockPrice{PriceBreaks: tiers, MOQ: 10, Multiples: 4},
+			quantity: 3, wantOK: true, wantOrderQty: 12, wantBreakQty: 10, wantUnit: "0.8000", wantExtended: "9.6000",
+		},
+		{
+			name:     "multiple reaches next break",
+			price:    model.StockPrice{PriceBreaks: tiers, Multiples: 50},
+			quantity: 60, wantOK: true, wantOrderQty: 100, wantBreakQty: 100, wantUnit: "0.5000", wantExtended: "50.0000",
+		},
+		{
+			name:     "moq defaults to first break",
+			price:    model.StockPrice{PriceBreaks: breaks(100, "0.50", 1000, "0.40")},
+			quantity: 1, wantOK: true, wantOrderQty: 100, wantBreakQty: 100, wantUnit: "0.5000", wantExtended: "50.0000",
+		},
+		{
+			name:     "order quantity below all breaks",
+			price:    model.StockPrice{PriceBreaks: breaks(100, "0.50"), MOQ: 20},
+			quantity: 1, wantOK: true, wantOrderQty: 20, wantBreakQty: 100, wantUnit: "0.5000", wantExtended: "10.0000",
+		},
+		{
+			name:     "unsorted breaks",
+			price:    model.StockPrice{PriceBreaks: breaks(100, "0.50", 1, "1.00", 10, "0.80")},
+			quantity: 99, wantOK: true, wantOrderQty: 99, wantBreakQty: 10, wantUnit: "0.8000", wantExtended: "79.2000",
+		},
+		{
+			name:     "step prices without original breaks",
+			price:    model.StockPrice{StepPrice1: "2", StepPrice3: "1.5"},
+			quantity: 150, wantOK: true, wantOrderQty: 150, wantBreakQty: 100, wantUnit: "1.5000", wantExtended: "225.0000",
+		},
+		{
+			name:     "extended price uses unrounded unit price",
+			price:    model.StockPrice{PriceBreaks: breaks(1, "0.12345")},
+			quantity: 3, wantOK: true, wantOrderQty: 3, wantBreakQty: 1, wantUnit: "0.1235", wantExtended: "0.3704",
+		},
+		{
+			name:     "no price",
+			price:    model.StockPrice{StockNum: 100},
+			quantity: 1,
+		},
+		{
+			name:     "zero price",
+			price:    model.StockPrice{PriceBreaks: breaks(1, "0")},
+			quantity: 1,
+		},
+		{
+			name:     "negative price",
+			price:    model.StockPrice{PriceBreaks: breaks(1, "-1.5")},
+			quantity: 1,
+		},
+		{
+			name:     "invalid price",
+			price:    model.StockPrice{PriceBreaks: breaks(1, "1,5")},
+			quantity: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quote, extended, ok := quotePrice(tt.price, tt.quantity)
+			if ok != tt.wantOK {
+				t.Fatalf("quotePrice() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if extended == nil {
+				t.Fatal("quotePrice() extended is nil")
+			}
+			if quote.OrderQty != tt.wantOrderQty || quote.BreakQty != tt.wantBreakQty {
+				t.Fatalf("quotePrice() order qty = %d, break qty = %d, want %d, %d", quote.OrderQty, quote.BreakQty, tt.wantOrderQty, tt.wantBreakQty)
+			}
+			if quote.UnitPrice != tt.wantUnit || quote.ExtendedPrice != tt.wantExtended {
+				t.Fatalf("quotePrice() unit = %s, extended = %s, want %s, %s", quote.UnitPrice, quote.ExtendedPrice, tt.wantUnit, tt.wantExtended)
+			}
+			if quote.StockEnough != tt.wantEnough {
+				t.Fatalf("quotePrice() stock enough = %v, want %v", quote.StockEnough, tt.wantEnough)
+			}
+		})
+	}
+}
+
+// initRates 加载测试汇率, 1 USD = 7 CNY, 1 EUR = 8 CNY, 没有 JPY
+func initRates(t *testing.T) {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "currency.json")
+	rates := `[{"Currency":"CNY","Rate":"1","UpdateTime":"2024-08-01T00:00:00Z"},
+		{"Currency":"USD","Rate":"7","UpdateTime":"2024-08-01T08:00:00Z"},
+		{"Currency":"EUR","Rate":"8","UpdateTime":"2024-08-01T06:00:00Z"}]`
+	if err := os.WriteFile(file, []byte(rates), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := currency.Init(currency.SourceConfig{Source: "file", File: file}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// offer 单一阶梯的报价, 以 DistributorID 区分
+func offer(distributorID int, currencyCode string, price string) model.StockPrice {
+	return model.StockPrice{DistributorID: distributorID, Currency: currencyCode, PriceBreaks: breaks(1, price)}
+}
+
+func TestRankQuotes(t *testing.T) {
+	initRates(t)
+
+	tests := []struct {
+		name           string
+		prices         []model.StockPrice
+		targetCurrency string
+		want           []int    // 排序后的 DistributorID
+		wantConverted  []string // 排序后的换算总价
+	}{
+		{
+			name:   "same currency by extended price",
+			prices: []model.StockPrice{offer(1, "CNY", "3"), offer(2, "CNY", "1"), offer(3, "CNY", "2")},
+			want:   []int{2, 3, 1},
+		},
+		{
+			name:   "ties keep original order",
+			prices: []model.StockPrice{offer(1, "CNY", "2"), offer(2, "CNY", "1"), offer(3, "CNY", "2.0000")},
+			want:   []int{2, 1, 3},
+		},
+		{
+			name:   "same currency without rates",
+			prices: []model.StockPrice{offer(1, "JPY", "300"), offer(2, "JPY", "200")},
+			want:   []int{2, 1},
+		},
+		{
+			// 直接比较金额时 10 USD 排在 60 CNY 之前, 换算为 USD 后 60 CNY 约为 8.57 USD
+			name:   "mixed currencies ranked in first currency",
+			prices: []model.StockPrice{offer(1, "USD", "1"), offer(2, "CNY", "6"), offer(3, "EUR", "1")},
+			want:   []int{2, 1, 3},
+		},
+		{
+			name:   "mixed currencies with missing rate last",
+			prices: []model.StockPrice{offer(1, "JPY", "0.01"), offer(2, "USD", "2"), offer(3, "CNY", "7")},
+			want:   []int{3, 2, 1},
+		},
+		{
+			name:           "target currency",
+			prices:         []model.StockPrice{offer(1, "USD", "1"), offer(2, "CNY", "6"), offer(3, "EUR", "0.5")},
+			targetCurrency: "CNY",
+			want:           []int{3, 2, 1},
+			wantConverted:  []string{"40.0000", "60.0000", "70.0000"},
+		},
+		{
+			name:           "target currency with missing rate last",
+			prices:         []model.StockPrice{offer(1, "JPY", "0.01"), offer(2, "USD", "1")},
+			targetCurrency: "CNY",
+			want:           []int{2, 1},
+			wantConverted:  []string{"70.0000", ""},
+		},
+		{
+			name:   "unquotable offers skipped",
+			prices: []model.StockPrice{{DistributorID: 1, Currency: "USD"}, offer(2, "CNY", "1"), offer(3, "USD", "0")},
+			want:   []int{2},
+		},
+		{
+			name:   "no quotable offers",
+			prices: []model.StockPrice{{DistributorID: 1, Currency: "USD"}, {DistributorID: 2, Currency: "CNY"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quotes, rateTime := rankQuotes(tt.prices, 10, tt.targetCurrency)
+			var got []int
+			var converted []string
+			for _, q := range quotes {
+				got = append(got, q.Price.DistributorID)
+				converted = append(converted, q.ConvertedExtendedPrice)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("rankQuotes() order = %v, want %v", got, tt.want)
+			}
+			if len(tt.targetCurrency) == 0 {
+				if !rateTime.IsZero() || slices.ContainsFunc(converted, func(s string) bool { return len(s) > 0 }) {
+					t.Fatalf("rankQuotes() converted without target currency: %v, %v", converted, rateTime)
+				}
+				return
+			}
+			if !slices.Equal(converted, tt.wantConverted) {
+				t.Fatalf("rankQuotes() converted = %v, want %v", converted, tt.wantConverted)
+			}
+			if rateTime.IsZero() {
+				t.Fatal("rankQuotes() rate time is zero")
+			}
+		})
+	}
+}
@@ -1,21 +1,39 @@
 package prices
 
 import (
+	"context"
 	"easyms-es/easyes"
+	"easyms-es/errno"
 	"easyms-es/model"
+	"easyms-es/service/currency"
 	"easyms-es/service/models"
 	"encoding/json"
 )
 
 var PriceStore easyes.Store
 
-func Search(pid int, size int, from int) (models.SearchPriceResult, error) {
+const (
+	collapseName       = "top"
+	maxCollapsePIDs    = 200
+	defaultCollapseTop = 3
+	maxCollapseTop     = 20
+)
+
+// Search 单产品价格搜索
+func Search(ctx context.Context, param model.PriceSearchParam) (models.SearchPriceResult, error) {
 	var result models.SearchPriceResult
-	result.From = int32(from)
-	result.Size = int32(size)
+	result.From = param.From
+	result.Size = param.Size
 
-	query := BuildSingleQuery(pid, from, size)
-	res, err := PriceStore.Search(query)
+	if len(param.TargetCurrency) > 0 && !currency.IsSupported(param.TargetCurrency) {
+		return result, errno.InvalidParam("target currency is not supported: %s", param.TargetCurrency)
+	}
+
+	query, err := BuildSingleQuery(param)
+	if err != nil {
+		return result, err
+	}
+	res, err := PriceStore.Search(ctx, query)
 
 	if err != nil {
 		return result, err
@@ -26,9 +44,36 @@ func Search(pid int, size int, from int) (models.SearchPriceResult, error) {
 		return result, err
 	}
 
+	if len(param.TargetCurrency) > 0 {
+		convertPrices(param.TargetCurrency, &result)
+	}
+
 	return result, nil
 }
 
+// convertPrices 将各阶梯价格换算为目标币种, 币种无汇率或价格无法解析时不换算
+func convertPrices(target string, result *models.SearchPriceResult) {
+	result.TargetCurrency = target
+	result.Converted = make(map[string]models.ConvertedPrice)
+
+	for _, price := range result.Results {
+		var converted models.ConvertedPrice
+		targets := []*string{&converted.Price1, &converted.Price2, &converted.Price3, &converted.Price4, &converted.Price5}
+		for i, stepPrice := range []string{price.StepPrice1, price.StepPrice2, price.StepPrice3, price.StepPrice4, price.StepPrice5} {
+			if len(stepPrice) == 0 || len(price.Currency) == 0 {
+				continue
+			}
+			value, rateTime, err := currency.Convert(stepPrice, price.Currency, target)
+			if err != nil {
+				continue
+			}
+			*targets[i] = value
+			result.RateTime = rateTime
+		}
+		result.Converted[price.SPID] = converted
+	}
+}
+
 func ResponseToStockPrice(rep easyes.SearchResponse, result *models.SearchPriceResult) error {
 	result.Total = int32(rep.Hits.Total.Value)
 	result.Above = rep.Hits.Total.Relation == "gte"
@@ -44,3 +89,65 @@ func ResponseToStockPrice(rep easyes.SearchResponse, result *models.SearchPriceR
 
 	return nil
 }
+
+// SearchByProducts 多产品价格搜索, 每个产品返回排序最靠前的 topSize 条价格
+func SearchByProducts(ctx context.Context, pids []int32, topSize int) (models.CollapseSearchPriceResult, error) {
+	var result models.CollapseSearchPriceResult
+	if len(pids) == 0 {
+		return result, nil
+	}
+	if len(pids) > maxCollapsePIDs {
+		return result, errno.InvalidParam("the number of PIDs exceeds the limit of %d", maxCollapsePIDs)
+	}
+	if topSize <= 0 {
+		topSize = defaultCollapseTop
+	}
+	if topSize > maxCollapseTop {
+		topSize = maxCollapseTop
+	}
+	result.Size = int32(topSize)
+
+	query, err := BuildCollapseQuery(pids, topSize)
+	if err != nil {
+		return result, err
+	}
+
+	res, err := PriceStore.SearchCollapse(ctx, query)
+	if err != nil {
+		return result, err
+	}
+
+	err = ResponseToCollapsePrice(*res, &result)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// ResponseToCollapsePrice 折叠结果转换, Total 为有价格的产品数量, 每个产品的 Total 为该产品的价格总数
+func ResponseToCollapsePrice(rep easyes.CollapseSearchResponse, result *models.CollapseSearchPriceResult) error {
+	for _, hit := range rep.Hits.Hits {
+		innerHits, ok := hit.InnerHits[collapseName]
+		if !ok {
+			continue
+		}
+
+		var collapse models.CollapsePrice
+		collapse.Total = int32(innerHits.Hits.Total.Value)
+		for _, innerHit := range innerHits.Hits.Hits {
+			var h model.StockPrice
+			if err := json.Unmarshal(innerHit.Source, &h); err != nil {
+				return err
+			}
+			collapse.Results = append(collapse.Results, h)
+		}
+		if len(collapse.Results) > 0 {
+			collapse.PID = int32(collapse.Results[0].PID)
+		}
+		result.Results = append(result.Results, collapse)
+	}
+	result.Total = int32(len(result.Results))
+
+	return nil
+}
@@ -1,24 +1,25 @@
 package prices
 
 import (
+	"context"
 	"easyms-es/model"
 )
 
 // BulkInsert 批量插入
-func BulkInsert(prices []model.StockPrice) error {
-	return bulkPrices(prices)
+func BulkInsert(ctx context.Context, prices []model.StockPrice) error {
+	return bulkPrices(ctx, prices)
 }
 
 // BulkRemove 批量删除
-func BulkRemove(prices []model.StockPrice) error {
-	return bulkPrices(prices, "delete")
+func BulkRemove(ctx context.Context, prices []model.StockPrice) error {
+	return bulkPrices(ctx, prices, "delete")
 }
 
 // 批量插入索引 对象要进行处理_id赋值
-func bulkPrices(prices []model.StockPrice, flag ...string) error {
+func bulkPrices(ctx context.Context, prices []model.StockPrice, flag ...string) error {
 	var data []interface{}
 	for _, price := range prices {
 		data = append(data, price)
 	}
-	return PriceStore.Bulk(data, flag...)
+	return PriceStore.Bulk(ctx, data, flag...)
 }
@@ -1,11 +1,12 @@
 package products
 
 import (
+	"context"
 	"easyms-es/easyes"
 )
 
-func Analyze(analyzer string, text string) (*easyes.Tokens, error) {
-	res, err := ProductStore.Analyze(analyzer, text)
+func Analyze(ctx context.Context, analyzer string, text string) (*easyes.Tokens, error) {
+	res, err := ProductStore.Analyze(ctx, analyzer, text)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,164 @@
+package products
+
+import (
+	"context"
+	"easyms-es/cache"
+	"easyms-es/db"
+	"easyms-es/easyes"
+	"easyms-es/errno"
+	"easyms-es/model"
+	"easyms-es/service/models"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// asyncOwner 异步搜索任务的归属, 同时记录分页参数用于结果转换
+type asyncOwner struct {
+	ClientID  string `json:"ClientID"`
+	From      int32  `json:"From"`
+	Size      int32  `json:"Size"`
+	ExpiredAt int64  `json:"ExpiredAt"`
+}
+
+// asyncOwners 未启用redis时的本地归属记录, 仅在单实例下有效
+var asyncOwners = struct {
+	sync.Mutex
+	owners map[string]asyncOwner
+}{owners: make(map[string]asyncOwner)}
+
+// SubmitSearch 提交异步产品搜索, withFacets 为 true 时同时执行分面聚合, 任务绑定到提交的客户端
+func SubmitSearch(ctx context.Context, clientID string, param model.ProductSearchParam, withFacets bool, facetSize int32) (string, error) {
+	query, err := buildSearchBody(param)
+	if err != nil {
+		return "", err
+	}
+	if withFacets {
+		aggs := make(map[string]any)
+		for aggName, agg := range facetAggs(facetSize) {
+			aggs[aggName] = agg
+		}
+		query["aggs"] = aggs
+	}
+
+	body, err := json.Marshal(query)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := ProductStore.AsyncSearch(ctx, string(body))
+	if err != nil {
+		return "", err
+	}
+
+	owner := asyncOwner{ClientID: clientID, ExpiredAt: time.Now().Add(easyes.AsyncSearchKeepAlive).Unix()}
+	owner.From, owner.Size = pageParam(param.From, param.Size)
+	if err := setAsyncOwner(id, owner); err != nil {
+		_, _ = ProductStore.CancelAsyncSearch(ctx, id)
+		return "", err
+	}
+	return id, nil
+}
+
+// GetSearchStatus 获取异步搜索状态及结果, 只有提交的客户端可以查看
+func GetSearchStatus(ctx context.Context, clientID string, id string) (models.AsyncSearchResult, error) {
+	var result models.AsyncSearchResult
+	owner, err := getAsyncOwner(clientID, id)
+	if err != nil {
+		return result, err
+	}
+
+	res, err := ProductStore.GetAsyncSearchResult(ctx, id)
+	if err != nil {
+		return result, err
+	}
+
+	result.ID = id
+	result.IsRunning = res.IsRunning
+	result.IsPartial = res.IsPartial
+	result.StartTime = res.StartTimeInMillis
+	result.ExpirationTime = res.ExpirationTimeInMillis
+	result.Products.From = owner.From
+	result.Products.Size = owner.Size
+
+	if err := ResponseToProducts(res.Response, &result.Products); err != nil {
+		return result, err
+	}
+	if len(res.Response.Aggregations) > 0 {
+		result.Facets = &models.SearchFacetResult{}
+		toFacetResult(res.Response.Aggregations, result.Facets)
+	}
+
+	return result, nil
+}
+
+// CancelSearch 取消异步搜索并删除结果, 只有提交的客户端可以取消
+func CancelSearch(ctx context.Context, clientID string, id string) error {
+	if _, err := getAsyncOwner(clientID, id); err != nil {
+		return err
+	}
+	if _, err := ProductStore.CancelAsyncSearch(ctx, id); err != nil {
+		return err
+	}
+	removeAsyncOwner(id)
+	return nil
+}
+
+// setAsyncOwner 记录任务归属, 有效期与异步搜索保持一致
+func setAsyncOwner(id string, owner asyncOwner) error {
+	if db.EasyRedis != nil {
+		return db.SetExpireCache(cache.GetAsyncSearchOwnerKey(id), owner, easyes.AsyncSearchKeepAlive)
+	}
+
+	asyncOwners.Lock()
+	defer asyncOwners.Unlock()
+	now := time.Now().Unix()
+	for key, o := range asyncOwners.owners {
+		if o.ExpiredAt < now {
+			delete(asyncOwners.owners, key)
+		}
+	}
+	asyncOwners.owners[id] = owner
+	return nil
+}
+
+// getAsyncOwner 校验任务归属, 不属于当前客户端时与不存在同样处理
+func getAsyncOwner(clientID string, id string) (asyncOwner, error) {
+	var owner asyncOwner
+	notFound := errno.NotFound("async search %s not found", id)
+
+	if db.EasyRedis != nil {
+		val, err := db.GetCache(cache.GetAsyncSearchOwnerKey(id))
+		if err != nil {
+			return owner, notFound
+		}
+		if err := json.Unmarshal([]byte(val), &owner); err != nil {
+			return owner, notFound
+		}
+	} else {
+		asyncOwners.Lock()
+		o, ok := asyncOwners.owners[id]
+		asyncOwners.Unlock()
+		if !ok || o.ExpiredAt < time.Now().Unix() {
+			return owner, notFound
+		}
+		owner = o
+	}
+
+	if owner.ClientID != clientID {
+		return owner, notFound
+	}
+	return owner, nil
+}
+
+// removeAsyncOwner 删除任务归属
+func removeAsyncOwner(id string) {
+	if db.EasyRedis != nil {
+		_ = db.RemoveKeyCache(cache.GetAsyncSearchOwnerKey(id))
+		return
+	}
+
+	asyncOwners.Lock()
+	delete(asyncOwners.owners, id)
+	asyncOwners.Unlock()
+}
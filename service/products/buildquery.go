@@ -0,0 +1,174 @@
+package products
+
+import (
+	"easyms-es/model"
+	"easyms-es/utility"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// FieldBoots 搜索字段权重, 顺序与 searchFields 一致, 由配置 common.elasticsearch.boots 覆盖
+var FieldBoots = []int{4, 3, 3, 4}
+
+// 参与关键词搜索的分词字段, 多余的权重配置忽略
+var searchFields = []string{"StandProductName", "StandBrand", "StandCategory", "PassiveParam"}
+
+const (
+	defaultSearchSize = 20
+	maxSearchSize     = 100
+)
+
+// getFieldBoot 获取字段权重, 未配置的字段权重为1
+func getFieldBoot(index int) int {
+	if index < len(FieldBoots) && FieldBoots[index] > 0 {
+		return FieldBoots[index]
+	}
+	return 1
+}
+
+// BuildSearchQuery 构建产品搜索DSL, 关键词走多字段匹配, 分项条件走单字段匹配, ID类条件走filter
+func BuildSearchQuery(param model.ProductSearchParam) (string, error) {
+	query, err := buildSearchBody(param)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(query)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// buildSearchBody 构建产品搜索的请求体
+func buildSearchBody(param model.ProductSearchParam) (map[string]any, error) {
+	boolQuery, err := buildBoolQuery(param)
+	if err != nil {
+		return nil, err
+	}
+
+	from, size := pageParam(param.From, param.Size)
+	query := map[string]any{
+		"query": boolQuery,
+		"sort": []any{
+			"_score",
+			map[string]any{"PriceGroup": map[string]any{"order": "desc"}},
+		},
+		"from": from,
+		"size": size,
+	}
+
+	// 只返回型号高亮, 见 ResponseToProducts
+	if param.IsHighlight {
+		query["highlight"] = map[string]any{
+			"pre_tags":  []string{"<em>"},
+			"post_tags": []string{"</em>"},
+			"fields":    map[string]any{"StandProductName": map[string]any{}},
+		}
+	}
+
+	return query, nil
+}
+
+// buildBoolQuery 构建产品搜索的bool查询条件
+func buildBoolQuery(param model.ProductSearchParam) (map[string]any, error) {
+	var must []any
+	var filter []any
+
+	if len(strings.TrimSpace(param.KeyWord)) > 0 {
+		keyWord, err := utility.StandProductKeyWord(param.KeyWord)
+		if err != nil {
+			return nil, err
+		}
+		var fields []string
+		for i, field := range searchFields {
+			fields = append(fields, fmt.Sprintf("%s^%d", field, getFieldBoot(i)))
+		}
+		must = append(must, map[string]any{
+			"multi_match": map[string]any{
+				"query":       keyWord,
+				"fields":      fields,
+				"type":        "best_fields",
+				"tie_breaker": 0.3,
+			},
+		})
+	}
+
+	// 分项条件, 与 searchFields 一一对应
+	fieldValues := []string{
+		utility.ReplaceStandProductNameStr(param.ProductName),
+		utility.ReplaceStandStr(param.Brand),
+		utility.ReplaceStandStr(param.Category),
+		utility.ReplaceStandStr(param.PassiveParam),
+	}
+	for i, value := range fieldValues {
+		if len(value) == 0 {
+			continue
+		}
+		must = append(must, map[string]any{
+			"match": map[string]any{
+				searchFields[i]: map[string]any{
+					"query":    value,
+					"operator": "and",
+					"boost":    getFieldBoot(i),
+				},
+			},
+		})
+	}
+
+	filter = append(filter, termsFilter("ParentID", param.ParentIDs)...)
+	filter = append(filter, termsFilter("CategoryID", param.CategoryIDs)...)
+	filter = append(filter, termsFilter("BrandID", param.BrandIDs)...)
+	filter = append(filter, termsFilter("DistributorIDs", param.DistributorIDs)...)
+	filter = append(filter, attributesFilter(param.Attributes)...)
+
+	if len(must) == 0 {
+		must = append(must, map[string]any{"match_all": map[string]any{}})
+	}
+
+	return map[string]any{
+		"bool": map[string]any{
+			"must":   must,
+			"filter": filter,
+		},
+	}, nil
+}
+
+// termsFilter 整型多值过滤
+func termsFilter(field string, values []int32) []any {
+	if utility.NullArrayIntCheck(values) {
+		return nil
+	}
+	return []any{map[string]any{"terms": map[string]any{field: values}}}
+}
+
+// attributesFilter 属性过滤, AttributeValues 存储格式为 {属性名}:{属性值}
+func attributesFilter(attributes []model.Attribute) []any {
+	var filter []any
+	for _, attribute := range attributes {
+		if len(attribute.AttributeName) == 0 || len(attribute.AttributeValues) == 0 {
+			continue
+		}
+		var values []string
+		for _, value := range attribute.AttributeValues {
+			values = append(values, fmt.Sprintf("{%s}:{%s}", attribute.AttributeName, value))
+		}
+		filter = append(filter, map[string]any{"terms": map[string]any{"AttributeValues": values}})
+	}
+	return filter
+}
+
+// pageParam 分页参数处理
+func pageParam(from int32, size int32) (int32, int32) {
+	if from < 0 {
+		from = 0
+	}
+	if size <= 0 {
+		size = defaultSearchSize
+	}
+	if size > maxSearchSize {
+		size = maxSearchSize
+	}
+	return from, size
+}
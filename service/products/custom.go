@@ -0,0 +1,221 @@
+package products
+
+import (
+	"context"
+	"easyms-es/errno"
+	"easyms-es/model"
+	"easyms-es/service/models"
+	"easyms-es/utility"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// productFields 产品索引的字段白名单, 由 model.Product 的 es 标签生成
+var productFields = utility.GetEsFieldTags(model.Product{})
+
+// 字段用途校验
+const (
+	fieldUsageKeyWord = "KeyWords"
+	fieldUsageFilter  = "Filters"
+	fieldUsageRange   = "Range"
+	fieldUsageSort    = "Sorts"
+	fieldUsageSource  = "Sources"
+)
+
+// checkField 校验字段是否允许用于指定用途, 分词字段只能用于关键词, 未索引字段只能用于返回
+func checkField(field string, usage string) error {
+	options, ok := productFields[field]
+	if !ok {
+		return errno.InvalidParam("custom search field is not allowed: %s", field)
+	}
+	esType := options["type"]
+	indexed := options["index"] != "false"
+
+	allowed := false
+	switch usage {
+	case fieldUsageKeyWord:
+		allowed = esType == "text"
+	case fieldUsageFilter, fieldUsageSort:
+		allowed = indexed && (esType == "keyword" || esType == "integer" || esType == "boolean" || esType == "date")
+	case fieldUsageRange:
+		allowed = indexed && (esType == "integer" || esType == "date")
+	case fieldUsageSource:
+		allowed = true
+	}
+	if !allowed {
+		return errno.InvalidParam("custom search field %s can not be used in %s", field, usage)
+	}
+	return nil
+}
+
+// CustomSearch 自定义产品搜索, 字段均需通过白名单校验
+func CustomSearch(ctx context.Context, param model.CustomSearchProductParam) (models.SearchProductResult, error) {
+	var result models.SearchProductResult
+	result.From, result.Size = pageParam(param.From, param.Size)
+
+	query, err := BuildCustomQuery(param)
+	if err != nil {
+		return result, err
+	}
+
+	res, err := ProductStore.Search(ctx, query)
+	if err != nil {
+		return result, err
+	}
+
+	err = ResponseToProducts(*res, &result)
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// BuildCustomQuery 构建自定义搜索DSL, Boots 与 searchFields 顺序一致, 未传则使用默认权重
+func BuildCustomQuery(param model.CustomSearchProductParam) (string, error) {
+	var must []any
+	var filter []any
+
+	boots := make(map[string]int)
+	for i, field := range searchFields {
+		boots[field] = getFieldBoot(i)
+		if i < len(param.Boots) && param.Boots[i] > 0 {
+			boots[field] = param.Boots[i]
+		}
+	}
+
+	for _, field := range sortedKeys(param.KeyWords) {
+		if err := checkField(field, fieldUsageKeyWord); err != nil {
+			return "", err
+		}
+		keyWords := strings.TrimSpace(strings.Join(param.KeyWords[field], " "))
+		if len(keyWords) == 0 {
+			continue
+		}
+		boot, ok := boots[field]
+		if !ok {
+			boot = 1
+		}
+		must = append(must, map[string]any{
+			"match": map[string]any{
+				field: map[string]any{"query": keyWords, "boost": boot},
+			},
+		})
+	}
+
+	for _, field := range sortedKeys(param.Filters) {
+		if err := checkField(field, fieldUsageFilter); err != nil {
+			return "", err
+		}
+		filter = append(filter, map[string]any{"term": map[string]any{field: param.Filters[field]}})
+	}
+
+	for _, field := range sortedKeys(param.TermsFilters) {
+		if err := checkField(field, fieldUsageFilter); err != nil {
+			return "", err
+		}
+		var values []string
+		for _, value := range strings.Split(param.TermsFilters[field], ",") {
+			if value = strings.TrimSpace(value); len(value) > 0 {
+				values = append(values, value)
+			}
+		}
+		if len(values) > 0 {
+			filter = append(filter, map[string]any{"terms": map[string]any{field: values}})
+		}
+	}
+
+	if len(param.Range.Field) > 0 {
+		if err := checkField(param.Range.Field, fieldUsageRange); err != nil {
+			return "", err
+		}
+		rangeValue := make(map[string]any)
+		if param.Range.Gte != nil {
+			rangeValue["gte"] = *param.Range.Gte
+		}
+		if param.Range.Lte != nil {
+			rangeValue["lte"] = *param.Range.Lte
+		}
+		if len(rangeValue) > 0 {
+			filter = append(filter, map[string]any{"range": map[string]any{param.Range.Field: rangeValue}})
+		}
+	}
+
+	if len(param.AttributeNames) > 0 {
+		filter = append(filter, map[string]any{"terms": map[string]any{"AttributeNames": param.AttributeNames}})
+	}
+
+	if len(must) == 0 {
+		must = append(must, map[string]any{"match_all": map[string]any{}})
+	}
+
+	var sorts []any
+	for _, field := range sortedKeys(param.Sorts) {
+		if err := checkField(field, fieldUsageSort); err != nil {
+			return "", err
+		}
+		order := strings.ToLower(param.Sorts[field])
+		if order != "asc" && order != "desc" {
+			return "", errno.InvalidParam("custom search sort order is error: %s %s", field, param.Sorts[field])
+		}
+		sorts = append(sorts, map[string]any{field: map[string]any{"order": order}})
+	}
+	sorts = append(sorts, "_score")
+
+	from, size := pageParam(param.From, param.Size)
+	query := map[string]any{
+		"query": map[string]any{
+			"bool": map[string]any{
+				"must":   must,
+				"filter": filter,
+			},
+		},
+		"sort": sorts,
+		"from": from,
+		"size": size,
+	}
+
+	if len(strings.TrimSpace(param.Sources)) > 0 {
+		var sources []string
+		for _, field := range strings.Split(param.Sources, ",") {
+			field = strings.TrimSpace(field)
+			if len(field) == 0 {
+				continue
+			}
+			if err := checkField(field, fieldUsageSource); err != nil {
+				return "", err
+			}
+			sources = append(sources, field)
+		}
+		query["_source"] = sources
+	}
+
+	if param.IsHighlight {
+		fields := make(map[string]any)
+		for field := range param.KeyWords {
+			fields[field] = map[string]any{}
+		}
+		query["highlight"] = map[string]any{
+			"pre_tags":  []string{"<em>"},
+			"post_tags": []string{"</em>"},
+			"fields":    fields,
+		}
+	}
+
+	body, err := json.Marshal(query)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// sortedKeys 对map的键排序, 保证生成的DSL稳定
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,31 @@
+package products
+
+import (
+	"easyms-es/model"
+	"easyms-es/utility"
+	"fmt"
+	"strings"
+)
+
+// BuildProduct 由产品源数据生成索引文档, 计算分词字段、型号首字母及扩展字段
+func BuildProduct(source model.ProductSource) model.Product {
+	product := model.Product{
+		PID:              source.PID,
+		StandProductName: utility.ReplaceStandProductNameStr(source.ProductName),
+		BrandID:          source.BrandID,
+		CategoryID:       source.CategoryID,
+		ParentID:         source.ParentID,
+		StandBrand:       utility.ReplaceMultipleSpacesWithSingle(source.Brand),
+	}
+
+	productName := strings.ToUpper(utility.ReplaceMultipleSpacesWithSingle(source.ProductName))
+
+	product.ProductNameIndex = utility.GetProductNameIndex(productName)
+
+	brand := utility.ReplaceMultipleSpacesWithSingle(source.Brand)
+
+	// 将商品名，品牌整合入ProductExt，注意后续的拼接{ProductName},{Brand},{Description},{Canonical}
+	product.ProductExt = fmt.Sprintf("{%s},{%s}", utility.RemoveFlagStr(productName), utility.RemoveFlagStr(brand))
+
+	return product
+}
@@ -0,0 +1,87 @@
+package products
+
+import (
+	"context"
+	"easyms-es/easyes"
+	"easyms-es/model"
+	"easyms-es/service/models"
+	"easyms-es/utility"
+	"encoding/json"
+)
+
+const (
+	exportKeepAlive        = "1m"
+	defaultExportChunkSize = 500
+	maxExportChunkSize     = 2000
+)
+
+// Export 产品导出, 通过 PIT + search_after 按PID顺序遍历, 每页回调一次
+// 回调阻塞时不会读取下一页, 续传令牌为当前页最后一个PID
+func Export(ctx context.Context, param model.ExportProductParam, fn func(chunk models.ExportProductChunk) error) error {
+	query, err := buildExportQuery(param)
+	if err != nil {
+		return err
+	}
+
+	return ProductStore.WalkPointInTime(ctx, query, exportKeepAlive, func(res *easyes.SearchResponse) error {
+		var chunk models.ExportProductChunk
+		chunk.Total = int32(res.Hits.Total.Value)
+		for _, hit := range res.Hits.Hits {
+			var p model.Product
+			if err := json.Unmarshal(hit.Source, &p); err != nil {
+				return err
+			}
+			chunk.Results = append(chunk.Results, p)
+		}
+
+		last := res.Hits.Hits[len(res.Hits.Hits)-1].Sort
+		if len(last) > 0 {
+			token, err := utility.EncodeResumeToken(last[:1])
+			if err != nil {
+				return err
+			}
+			chunk.ResumeToken = token
+		}
+		return fn(chunk)
+	})
+}
+
+// buildExportQuery 构建导出DSL, 续传时以 PID 范围过滤代替 search_after, 令牌可跨PIT使用
+func buildExportQuery(param model.ExportProductParam) (map[string]any, error) {
+	var filter []any
+	if param.BrandID > 0 {
+		filter = append(filter, map[string]any{"term": map[string]any{"BrandID": param.BrandID}})
+	}
+	if param.ParentID > 0 {
+		filter = append(filter, map[string]any{"term": map[string]any{"ParentID": param.ParentID}})
+	}
+	if param.CategoryID > 0 {
+		filter = append(filter, map[string]any{"term": map[string]any{"CategoryID": param.CategoryID}})
+	}
+	if param.DistributorID > 0 {
+		filter = append(filter, map[string]any{"term": map[string]any{"DistributorIDs": param.DistributorID}})
+	}
+	if len(param.ResumeToken) > 0 {
+		values, err := utility.DecodeResumeToken(param.ResumeToken, 1)
+		if err != nil {
+			return nil, err
+		}
+		filter = append(filter, map[string]any{"range": map[string]any{"PID": map[string]any{"gt": values[0]}}})
+	}
+
+	size := param.ChunkSize
+	if size <= 0 {
+		size = defaultExportChunkSize
+	}
+	if size > maxExportChunkSize {
+		size = maxExportChunkSize
+	}
+
+	return map[string]any{
+		"query": map[string]any{
+			"bool": map[string]any{"filter": filter},
+		},
+		"sort": []any{map[string]any{"PID": map[string]any{"order": "asc"}}},
+		"size": size,
+	}, nil
+}
@@ -0,0 +1,382 @@
+package products
+
+import (
+	"context"
+	"easyms-es/cache"
+	"easyms-es/db"
+	"easyms-es/easyes"
+	"easyms-es/model"
+	"easyms-es/service/models"
+	"easyms-es/utility"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 聚合名称, 与缓存键的后缀保持一致
+const (
+	categoryAggName       = "categoryagg"
+	brandAggName          = "brandagg"
+	distributorAggName    = "distributoragg"
+	attributeNameAggName  = "attrinameagg"
+	attributeValueAggName = "attrivalusagg"
+)
+
+var facetAggNames = []string{categoryAggName, brandAggName, distributorAggName, attributeNameAggName, attributeValueAggName}
+
+const (
+	defaultFacetSize = 20
+	maxFacetSize     = 200
+)
+
+// CacheTTL 分类聚合及首字母索引缓存的有效期
+var CacheTTL = 10 * time.Minute
+
+// SearchFacets 分面导航聚合, 只有分类条件且使用默认数量时优先读取redis缓存, 缓存缺失时实时聚合并写入缓存
+func SearchFacets(ctx context.Context, param model.ProductSearchParam, size int32) (models.SearchFacetResult, error) {
+	prentCategory, category, cacheable := categoryContext(param)
+	cacheable = cacheable && (size <= 0 || size == defaultFacetSize)
+	if cacheable {
+		if result, ok := getCacheFacets(prentCategory, category); ok {
+			return result, nil
+		}
+	}
+
+	result, err := searchLiveFacets(ctx, param, size)
+	if err == nil && cacheable {
+		setCacheFacets(prentCategory, category, result)
+	}
+	return result, err
+}
+
+// categoryContext 判断是否为单纯的分类上下文, 返回缓存键使用的父级分类与分类
+func categoryContext(param model.ProductSearchParam) (string, string, bool) {
+	if len(param.KeyWord) > 0 || len(param.ProductName) > 0 || len(param.Brand) > 0 ||
+		len(param.Category) > 0 || len(param.PassiveParam) > 0 || len(param.BrandIDs) > 0 ||
+		len(param.DistributorIDs) > 0 || len(param.Attributes) > 0 {
+		return "", "", false
+	}
+	if len(param.ParentIDs) > 1 || len(param.CategoryIDs) > 1 {
+		return "", "", false
+	}
+	switch {
+	case len(param.ParentIDs) == 1 && len(param.CategoryIDs) == 1:
+		return strconv.Itoa(int(param.ParentIDs[0])), strconv.Itoa(int(param.CategoryIDs[0])), true
+	case len(param.ParentIDs) == 1:
+		return "", strconv.Itoa(int(param.ParentIDs[0])), true
+	case len(param.CategoryIDs) == 1:
+		return "", strconv.Itoa(int(param.CategoryIDs[0])), true
+	}
+	return "", "", false
+}
+
+// facetCacheKeys 各分面的缓存键, 与 facetBuckets 按下标对应
+func facetCacheKeys(prentCategory string, category string) []string {
+	return []string{
+		cache.GetCategorySearchCategoryAggKey(prentCategory, category),
+		cache.GetCategorySearchBrandAggKey(prentCategory, category),
+		cache.GetCategorySearchDistributorAggKey(prentCategory, category),
+		cache.GetCategorySearchAttributeNameAggKey(prentCategory, category),
+		cache.GetCategorySearchAttributeValueAggKey(prentCategory, category),
+	}
+}
+
+// facetBuckets 分面结果中各分面的桶
+func facetBuckets(result *models.SearchFacetResult) []*[]models.FacetBucket {
+	return []*[]models.FacetBucket{
+		&result.Categories,
+		&result.Brands,
+		&result.Distributors,
+		&result.AttributeNames,
+		&result.AttributeValues,
+	}
+}
+
+// getCacheFacets 读取分类聚合缓存, 任一缓存缺失则视为未命中
+func getCacheFacets(prentCategory string, category string) (models.SearchFacetResult, bool) {
+	var result models.SearchFacetResult
+	if db.EasyRedis == nil {
+		return result, false
+	}
+
+	targets := facetBuckets(&result)
+	for i, key := range facetCacheKeys(prentCategory, category) {
+		val, err := db.GetCache(key)
+		if err != nil {
+			return result, false
+		}
+		if err := json.Unmarshal([]byte(val), targets[i]); err != nil {
+			return result, false
+		}
+	}
+
+	result.IsCache = true
+	return result, true
+}
+
+// setCacheFacets 写入分类聚合缓存, 各分面分别保存为 []FacetBucket 的JSON, 写入失败只记录日志
+func setCacheFacets(prentCategory string, category string, result models.SearchFacetResult) {
+	if db.EasyRedis == nil {
+		return
+	}
+
+	buckets := facetBuckets(&result)
+	for i, key := range facetCacheKeys(prentCategory, category) {
+		if err := db.SetExpireCache(key, *buckets[i], CacheTTL); err != nil {
+			log.Printf("failed to cache facets %s: %v", key, err)
+			return
+		}
+	}
+}
+
+// searchLiveFacets 通过msearch并发执行各个分面聚合
+func searchLiveFacets(ctx context.Context, param model.ProductSearchParam, size int32) (models.SearchFacetResult, error) {
+	var result models.SearchFacetResult
+
+	body, err := BuildFacetQuery(param, size)
+	if err != nil {
+		return result, err
+	}
+
+	res, err := ProductStore.SearchAggMultiple(ctx, body)
+	if err != nil {
+		return result, err
+	}
+
+	responses := *res
+	for i, aggName := range facetAggNames {
+		if i >= len(responses) {
+			break
+		}
+		if agg, ok := responses[i].Aggregations[aggName]; ok {
+			toFacetResult(map[string]easyes.Aggregation{aggName: agg}, &result)
+		}
+	}
+
+	return result, nil
+}
+
+// BuildFacetQuery 构建分面聚合的msearch请求体, 每个分面一个独立请求
+func BuildFacetQuery(param model.ProductSearchParam, size int32) (string, error) {
+	boolQuery, err := buildBoolQuery(param)
+	if err != nil {
+		return "", err
+	}
+
+	aggs := facetAggs(size)
+
+	header, err := json.Marshal(map[string]string{"index": ProductStore.IndexName})
+	if err != nil {
+		return "", err
+	}
+
+	var buf strings.Builder
+	for _, aggName := range facetAggNames {
+		body, err := json.Marshal(map[string]any{
+			"size":  0,
+			"query": boolQuery,
+			"aggs":  map[string]any{aggName: aggs[aggName]},
+		})
+		if err != nil {
+			return "", err
+		}
+		buf.Write(header)
+		buf.WriteString("\n")
+		buf.Write(body)
+		buf.WriteString("\n")
+	}
+
+	return buf.String(), nil
+}
+
+// facetAggs 各分面的聚合定义, 键为聚合名称
+func facetAggs(size int32) map[string]map[string]any {
+	if size <= 0 {
+		size = defaultFacetSize
+	}
+	if size > maxFacetSize {
+		size = maxFacetSize
+	}
+
+	topHits := func(sources ...string) map[string]any {
+		return map[string]any{
+			"top": map[string]any{
+				"top_hits": map[string]any{"size": 1, "_source": sources},
+			},
+		}
+	}
+
+	return map[string]map[string]any{
+		categoryAggName: {
+			"terms": map[string]any{"field": "ParentID", "size": size},
+			"aggs": map[string]any{
+				"child_agg": map[string]any{
+					"terms": map[string]any{"field": "CategoryID", "size": size},
+					"aggs":  topHits("Category"),
+				},
+			},
+		},
+		brandAggName: {
+			"terms": map[string]any{"field": "BrandID", "size": size},
+			"aggs":  topHits("Manufacturer", "ProductExt"),
+		},
+		distributorAggName: {
+			"terms": map[string]any{"field": "DistributorIDs", "size": size},
+			"aggs":  topHits("DistributorIDs", "DistributorNames"),
+		},
+		attributeNameAggName: {
+			"terms": map[string]any{"field": "AttributeNames", "size": size},
+		},
+		attributeValueAggName: {
+			"terms": map[string]any{"field": "AttributeValues", "size": size * 10},
+		},
+	}
+}
+
+// toFacetResult 聚合结果转换为分面结果
+func toFacetResult(aggregations map[string]easyes.Aggregation, result *models.SearchFacetResult) {
+	for aggName, agg := range aggregations {
+		switch aggName {
+		case categoryAggName:
+			result.Categories = toCategoryBuckets(agg)
+		case brandAggName:
+			result.Brands = toBrandBuckets(agg)
+		case distributorAggName:
+			result.Distributors = toDistributorBuckets(agg)
+		case attributeNameAggName:
+			result.AttributeNames = toAttributeNameBuckets(agg)
+		case attributeValueAggName:
+			result.AttributeValues = toAttributeValueBuckets(agg)
+		}
+	}
+}
+
+// toCategoryBuckets 父级分类 -> 子分类, 分类名称取自命中文档的 Category 扩展字段
+func toCategoryBuckets(agg easyes.Aggregation) []models.FacetBucket {
+	var buckets []models.FacetBucket
+	for _, b := range agg.Buckets {
+		parent := models.FacetBucket{Key: bucketKey(b.Key), Count: b.DocCount}
+		if b.ChildAgg != nil {
+			for _, c := range b.ChildAgg.Buckets {
+				child := models.FacetBucket{Key: bucketKey(c.Key), Count: c.DocCount}
+				if len(c.Top.Hits.Hits) > 0 {
+					var source struct {
+						Category string `json:"Category"`
+					}
+					if err := json.Unmarshal(c.Top.Hits.Hits[0].Source, &source); err == nil {
+						category := utility.SplitStrToMap(source.Category)
+						if len(category[0]) > 0 && len(parent.Name) == 0 {
+							parent.Name = category[0][0]
+						}
+						if len(category[1]) > 0 {
+							child.Name = category[1][0]
+						}
+					}
+				}
+				parent.Children = append(parent.Children, child)
+			}
+		}
+		buckets = append(buckets, parent)
+	}
+	return buckets
+}
+
+// toBrandBuckets 品牌, 名称优先取品牌扩展字段
+func toBrandBuckets(agg easyes.Aggregation) []models.FacetBucket {
+	var buckets []models.FacetBucket
+	for _, b := range agg.Buckets {
+		bucket := models.FacetBucket{Key: bucketKey(b.Key), Count: b.DocCount}
+		if len(b.Top.Hits.Hits) > 0 {
+			var source struct {
+				Manufacturer string `json:"Manufacturer"`
+				ProductExt   string `json:"ProductExt"`
+			}
+			if err := json.Unmarshal(b.Top.Hits.Hits[0].Source, &source); err == nil {
+				manufacturer := utility.SplitStrToMap(source.Manufacturer)
+				productExt := utility.SplitStrToMap(source.ProductExt)
+				if len(manufacturer[0]) > 0 && len(manufacturer[0][0]) > 0 {
+					bucket.Name = manufacturer[0][0]
+				} else if len(productExt[0]) > 1 {
+					bucket.Name = productExt[0][1]
+				}
+			}
+		}
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
+
+// toDistributorBuckets 分销商, DistributorIDs 与 DistributorNames 按下标一一对应
+func toDistributorBuckets(agg easyes.Aggregation) []models.FacetBucket {
+	var buckets []models.FacetBucket
+	for _, b := range agg.Buckets {
+		bucket := models.FacetBucket{Key: bucketKey(b.Key), Count: b.DocCount}
+		if len(b.Top.Hits.Hits) > 0 {
+			var source struct {
+				DistributorIDs   []int    `json:"DistributorIDs"`
+				DistributorNames []string `json:"DistributorNames"`
+			}
+			if err := json.Unmarshal(b.Top.Hits.Hits[0].Source, &source); err == nil {
+				for i, id := range source.DistributorIDs {
+					if strconv.Itoa(id) == bucket.Key && i < len(source.DistributorNames) {
+						bucket.Name = source.DistributorNames[i]
+						break
+					}
+				}
+			}
+		}
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
+
+// toAttributeNameBuckets 属性名, 存储格式 {属性分类}:{属性名}
+func toAttributeNameBuckets(agg easyes.Aggregation) []models.FacetBucket {
+	var buckets []models.FacetBucket
+	for _, b := range agg.Buckets {
+		bucket := models.FacetBucket{Key: bucketKey(b.Key), Count: b.DocCount}
+		if group, name, err := utility.SplitAttrValue(bucket.Key); err == nil {
+			bucket.Group = group
+			bucket.Name = name
+		}
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
+
+// toAttributeValueBuckets 属性值按属性名分组, 存储格式 {属性名}:{属性值}
+func toAttributeValueBuckets(agg easyes.Aggregation) []models.FacetBucket {
+	var buckets []models.FacetBucket
+	indexes := make(map[string]int)
+	for _, b := range agg.Buckets {
+		key := bucketKey(b.Key)
+		name, value, err := utility.SplitAttrValue(key)
+		if err != nil {
+			continue
+		}
+		i, ok := indexes[name]
+		if !ok {
+			i = len(buckets)
+			indexes[name] = i
+			buckets = append(buckets, models.FacetBucket{Key: name, Name: name})
+		}
+		buckets[i].Count += b.DocCount
+		buckets[i].Children = append(buckets[i].Children, models.FacetBucket{Key: key, Name: value, Count: b.DocCount})
+	}
+	return buckets
+}
+
+// bucketKey 聚合键转字符串, 数值型键解码后为float64
+func bucketKey(key any) string {
+	switch v := key.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
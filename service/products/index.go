@@ -0,0 +1,169 @@
+package products
+
+import (
+	"context"
+	"easyms-es/cache"
+	"easyms-es/db"
+	"easyms-es/model"
+	"easyms-es/service/models"
+	"easyms-es/utility"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+)
+
+const (
+	indexAggName = "indexagg"
+	maxNameIndex = 37
+)
+
+// BrowseIndex 型号首字母索引浏览, 按PID游标分页, 同时返回各首字母的数量
+func BrowseIndex(ctx context.Context, param model.ProductIndexSearchParam) (models.SearchProductIndexResult, error) {
+	var result models.SearchProductIndexResult
+	_, result.Size = pageParam(0, param.Size)
+
+	indexes, isCache := getCacheIndexCounts(param.ProductIndexParam)
+
+	query, err := BuildIndexQuery(param, !isCache)
+	if err != nil {
+		return result, err
+	}
+
+	res, err := ProductStore.Search(ctx, query)
+	if err != nil {
+		return result, err
+	}
+
+	for _, hit := range res.Hits.Hits {
+		var p model.Product
+		if err := json.Unmarshal(hit.Source, &p); err != nil {
+			return result, err
+		}
+		result.Results = append(result.Results, p)
+	}
+	// 没有更多结果时返回原游标, 避免客户端回传0后从头开始
+	result.LastPID = param.LastPID
+	if len(result.Results) > 0 {
+		result.LastPID = int32(result.Results[len(result.Results)-1].PID)
+	}
+
+	if !isCache {
+		for _, b := range res.Aggregations[indexAggName].Buckets {
+			index, ok := b.Key.(float64)
+			if !ok || index < 1 || index > maxNameIndex {
+				continue
+			}
+			indexes = append(indexes, models.ProductIndexCount{
+				ProductNameIndex: int(index),
+				Initial:          utility.GetProductNameStartStr(int(index)),
+				Count:            b.DocCount,
+			})
+		}
+		sort.Slice(indexes, func(i, j int) bool {
+			return indexes[i].ProductNameIndex < indexes[j].ProductNameIndex
+		})
+		setCacheIndexCounts(param.ProductIndexParam, indexes)
+	}
+	result.Indexes = indexes
+
+	// 总数取当前首字母的数量, 不受游标影响
+	for _, index := range indexes {
+		if param.ProductNameIndex == 0 || int32(index.ProductNameIndex) == param.ProductNameIndex {
+			result.Total += int32(index.Count)
+		}
+	}
+
+	return result, nil
+}
+
+// BuildIndexQuery 构建首字母索引DSL, 首字母及游标条件放在 post_filter 中, 以便聚合统计全部首字母
+func BuildIndexQuery(param model.ProductIndexSearchParam, withAgg bool) (string, error) {
+	var filter []any
+	if param.IsFollow {
+		filter = append(filter, map[string]any{"term": map[string]any{"Follow": true}})
+	}
+	if param.ParentID > 0 {
+		filter = append(filter, map[string]any{"term": map[string]any{"ParentID": param.ParentID}})
+	}
+	if param.CategoryID > 0 {
+		filter = append(filter, map[string]any{"term": map[string]any{"CategoryID": param.CategoryID}})
+	}
+
+	var postFilter []any
+	if param.ProductNameIndex > 0 {
+		postFilter = append(postFilter, map[string]any{"term": map[string]any{"ProductNameIndex": param.ProductNameIndex}})
+	}
+	if param.LastPID > 0 {
+		postFilter = append(postFilter, map[string]any{"range": map[string]any{"PID": map[string]any{"gt": param.LastPID}}})
+	}
+
+	_, size := pageParam(0, param.Size)
+	query := map[string]any{
+		"query": map[string]any{
+			"bool": map[string]any{"filter": filter},
+		},
+		"post_filter": map[string]any{
+			"bool": map[string]any{"filter": postFilter},
+		},
+		"sort": []any{map[string]any{"PID": map[string]any{"order": "asc"}}},
+		"size": size,
+	}
+	if withAgg {
+		query["aggs"] = map[string]any{
+			indexAggName: map[string]any{
+				"terms": map[string]any{"field": "ProductNameIndex", "size": maxNameIndex},
+			},
+		}
+	}
+
+	body, err := json.Marshal(query)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// indexCacheKey 首字母数量缓存键, 分类下使用分类索引缓存(不区分SEO), 其他使用 {ParentID}-{Follow} 缓存, 0-0 为首页索引
+func indexCacheKey(param model.ProductIndexParam) (string, bool) {
+	if param.CategoryID > 0 {
+		if param.IsFollow {
+			return "", false
+		}
+		return cache.GetIndexKeyWithCategoryId(int(param.CategoryID)), true
+	}
+	follow := 0
+	if param.IsFollow {
+		follow = 1
+	}
+	return cache.GetIndexKey(fmt.Sprintf("%d-%d", param.ParentID, follow)), true
+}
+
+// getCacheIndexCounts 读取首字母数量缓存
+func getCacheIndexCounts(param model.ProductIndexParam) ([]models.ProductIndexCount, bool) {
+	key, ok := indexCacheKey(param)
+	if !ok || db.EasyRedis == nil {
+		return nil, false
+	}
+
+	val, err := db.GetCache(key)
+	if err != nil {
+		return nil, false
+	}
+	var indexes []models.ProductIndexCount
+	if err := json.Unmarshal([]byte(val), &indexes); err != nil {
+		return nil, false
+	}
+	return indexes, true
+}
+
+// setCacheIndexCounts 写入首字母数量缓存, 保存为 []ProductIndexCount 的JSON, 写入失败只记录日志
+func setCacheIndexCounts(param model.ProductIndexParam, indexes []models.ProductIndexCount) {
+	key, ok := indexCacheKey(param)
+	if !ok || db.EasyRedis == nil {
+		return
+	}
+	if err := db.SetExpireCache(key, indexes, CacheTTL); err != nil {
+		log.Printf("failed to cache product indexes %s: %v", key, err)
+	}
+}
@@ -0,0 +1,75 @@
+package products
+
+import (
+	"context"
+	"easyms-es/model"
+	"easyms-es/utility"
+	"encoding/json"
+)
+
+// MatchProducts 按标准化型号匹配产品, fuzzy 为 false 时使用型号分词匹配(命中完全及前缀), 为 true 时使用模糊匹配
+// 品牌只参与打分, 不作为过滤条件
+func MatchProducts(ctx context.Context, partNo string, brand string, size int32, fuzzy bool) ([]model.Product, error) {
+	match := map[string]any{
+		"query":    partNo,
+		"operator": "and",
+		"boost":    getFieldBoot(0),
+	}
+	if fuzzy {
+		match["fuzziness"] = "AUTO"
+		match["prefix_length"] = 2
+	}
+
+	boolQuery := map[string]any{
+		"must": []any{
+			map[string]any{"match": map[string]any{"StandProductName": match}},
+		},
+	}
+	if brand = utility.ReplaceStandStr(brand); len(brand) > 0 {
+		boolQuery["should"] = []any{
+			map[string]any{"match": map[string]any{"StandBrand": map[string]any{"query": brand, "boost": getFieldBoot(1)}}},
+		}
+	}
+
+	_, size = pageParam(0, size)
+	query := map[string]any{
+		"query": map[string]any{"bool": boolQuery},
+		"sort": []any{
+			"_score",
+			map[string]any{"PriceGroup": map[string]any{"order": "desc"}},
+		},
+		"size": size,
+	}
+	body, err := json.Marshal(query)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := ProductStore.Search(ctx, string(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var results []model.Product
+	for _, hit := range res.Hits.Hits {
+		var p model.Product
+		if err := json.Unmarshal(hit.Source, &p); err != nil {
+			return nil, err
+		}
+		results = append(results, p)
+	}
+	return results, nil
+}
+
+// GetProductBrand 从品牌及产品扩展字段中获取品牌名称
+func GetProductBrand(p model.Product) string {
+	manufacturer := utility.SplitStrToMap(p.Manufacturer)
+	if len(manufacturer[0]) > 0 && len(manufacturer[0][0]) > 0 {
+		return manufacturer[0][0]
+	}
+	productExt := utility.SplitStrToMap(p.ProductExt)
+	if len(productExt[0]) > 1 {
+		return productExt[0][1]
+	}
+	return ""
+}
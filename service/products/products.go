@@ -1,6 +1,7 @@
 package products
 
 import (
+	"context"
 	"easyms-es/easyes"
 	"easyms-es/model"
 )
@@ -8,15 +9,15 @@ import (
 var ProductStore easyes.Store
 
 // BulkInsert 批量插入
-func BulkInsert(products []model.Product) error {
-	return bulkProducts(products, "index")
+func BulkInsert(ctx context.Context, products []model.Product) error {
+	return bulkProducts(ctx, products, "index")
 }
 
 // 批量插入索引 对象要进行处理_id赋值
-func bulkProducts(products []model.Product, flag ...string) error {
+func bulkProducts(ctx context.Context, products []model.Product, flag ...string) error {
 	var data []interface{}
 	for _, part := range products {
 		data = append(data, part)
 	}
-	return ProductStore.Bulk(data, flag...)
+	return ProductStore.Bulk(ctx, data, flag...)
 }
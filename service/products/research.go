@@ -0,0 +1,258 @@
+package products
+
+import (
+	"context"
+	"easyms-es/model"
+	"easyms-es/service/models"
+	"easyms-es/utility"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+const (
+	minPrefixLength    = 3
+	maxPrefixAttempts  = 4
+	maxSimilarNames    = 10
+	termSuggestName    = "termsuggest"
+	phraseSuggestName  = "phrasesuggest"
+	suggestProductName = "StandProductName"
+)
+
+// reSearch 型号搜索无结果时依次尝试: 截短前缀, 建议器纠错及模糊匹配, 命中后标记 IsReSearch
+// 同一步骤的多个查询合并为一次msearch, 最多3次ES请求
+func reSearch(ctx context.Context, param model.ProductSearchParam, result *models.SearchProductResult) error {
+	partNo := reSearchPartNo(param)
+	if len(partNo) <= minPrefixLength {
+		return nil
+	}
+
+	// 重新搜索时只按型号查询, 关键词不再参与
+	param.KeyWord = ""
+	param.IsReSearch = true
+
+	// 1. 逐步截短前缀, 取最长的有结果的前缀
+	var queries []string
+	for i, prefix := range shorterPrefixes(partNo) {
+		if i >= maxPrefixAttempts {
+			break
+		}
+		param.ProductName = prefix
+		query, err := buildReSearchQuery(param, "")
+		if err != nil {
+			return err
+		}
+		queries = append(queries, query)
+	}
+	ok, err := tryReSearch(ctx, param, queries, result)
+	if err != nil || ok {
+		return err
+	}
+
+	// 2. 建议器纠错, 建议的型号优先于模糊匹配
+	suggestions, err := suggestProductNames(ctx, partNo)
+	if err != nil {
+		return err
+	}
+	queries = queries[:0]
+	if len(suggestions) > 0 {
+		param.ProductName = suggestions[0]
+		query, err := buildReSearchQuery(param, "")
+		if err != nil {
+			return err
+		}
+		queries = append(queries, query)
+	}
+
+	// 3. 模糊匹配
+	param.ProductName = ""
+	query, err := buildReSearchQuery(param, partNo)
+	if err != nil {
+		return err
+	}
+	queries = append(queries, query)
+
+	ok, err = tryReSearch(ctx, param, queries, result)
+	if err != nil {
+		return err
+	}
+	if ok {
+		result.SimilarProductNames = mergeNames(suggestions, result.SimilarProductNames)
+	}
+	return nil
+}
+
+// reSearchPartNo 需要重新搜索的型号, 优先型号条件, 其次为不含空格的关键词
+func reSearchPartNo(param model.ProductSearchParam) string {
+	if len(strings.TrimSpace(param.ProductName)) > 0 {
+		return utility.ReplaceStandProductNameStr(param.ProductName)
+	}
+	keyWord := strings.TrimSpace(param.KeyWord)
+	if len(keyWord) == 0 || strings.Contains(keyWord, " ") {
+		return ""
+	}
+	return utility.ReplaceStandProductNameStr(keyWord)
+}
+
+// shorterPrefixes 由长到短的型号前缀, 不含型号本身, 最短为 minPrefixLength
+func shorterPrefixes(partNo string) []string {
+	prefixes := strings.Split(utility.SegmentationProductName(partNo), " ")
+	var rst []string
+	for i := len(prefixes) - 1; i >= 0; i-- {
+		if prefixes[i] == partNo || len(prefixes[i]) < minPrefixLength {
+			continue
+		}
+		rst = append(rst, prefixes[i])
+	}
+	return rst
+}
+
+// tryReSearch 通过一次msearch执行多个重新搜索, 第一个有结果的写入 result
+func tryReSearch(ctx context.Context, param model.ProductSearchParam, queries []string, result *models.SearchProductResult) (bool, error) {
+	if len(queries) == 0 {
+		return false, nil
+	}
+
+	responses, err := ProductStore.MultiSearch(ctx, queries)
+	if err != nil {
+		return false, err
+	}
+
+	for _, res := range responses {
+		if res.Hits.Total.Value == 0 {
+			continue
+		}
+
+		var reResult models.SearchProductResult
+		reResult.From, reResult.Size = pageParam(param.From, param.Size)
+		if err := ResponseToProducts(res, &reResult); err != nil {
+			return false, err
+		}
+
+		reResult.IsReSearch = true
+		for _, p := range reResult.Results {
+			reResult.SimilarProductNames = mergeNames(reResult.SimilarProductNames, []string{GetProductName(p)})
+		}
+		*result = reResult
+		return true, nil
+	}
+	return false, nil
+}
+
+// buildReSearchQuery 重新搜索DSL, 模糊匹配时在原条件上追加型号的 fuzzy match
+func buildReSearchQuery(param model.ProductSearchParam, fuzzy string) (string, error) {
+	if len(fuzzy) == 0 {
+		return BuildSearchQuery(param)
+	}
+
+	boolQuery, err := buildBoolQuery(param)
+	if err != nil {
+		return "", err
+	}
+	fuzzyMatch := map[string]any{
+		"match": map[string]any{
+			"StandProductName": map[string]any{
+				"query":         fuzzy,
+				"fuzziness":     "AUTO",
+				"prefix_length": 2,
+				"boost":         getFieldBoot(0),
+			},
+		},
+	}
+	boolQuery["bool"].(map[string]any)["must"] = []any{fuzzyMatch}
+
+	from, size := pageParam(param.From, param.Size)
+	query := map[string]any{
+		"query": boolQuery,
+		"sort": []any{
+			"_score",
+			map[string]any{"PriceGroup": map[string]any{"order": "desc"}},
+		},
+		"from": from,
+		"size": size,
+	}
+	if param.IsHighlight {
+		query["highlight"] = map[string]any{
+			"pre_tags":  []string{"<em>"},
+			"post_tags": []string{"</em>"},
+			"fields":    map[string]any{"StandProductName": map[string]any{}},
+		}
+	}
+
+	body, err := json.Marshal(query)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// suggestProductNames 通过 term 及 phrase 建议器获取相似型号, 按得分由高到低
+func suggestProductNames(ctx context.Context, partNo string) ([]string, error) {
+	query := map[string]any{
+		"size": 0,
+		"suggest": map[string]any{
+			"text": partNo,
+			termSuggestName: map[string]any{
+				"term": map[string]any{
+					"field":        suggestProductName,
+					"suggest_mode": "always",
+					"size":         maxSimilarNames,
+				},
+			},
+			phraseSuggestName: map[string]any{
+				"phrase": map[string]any{
+					"field": suggestProductName,
+					"size":  maxSimilarNames,
+				},
+			},
+		},
+	}
+	body, err := json.Marshal(query)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := ProductStore.Search(ctx, string(body))
+	if err != nil {
+		return nil, err
+	}
+
+	type option struct {
+		text  string
+		score float64
+	}
+	var options []option
+	for _, name := range []string{phraseSuggestName, termSuggestName} {
+		for _, suggest := range res.Suggest[name] {
+			for _, o := range suggest.Options {
+				text := utility.ReplaceStandProductNameStr(o.Text)
+				if len(text) >= minPrefixLength && text != partNo {
+					options = append(options, option{text: text, score: o.Score})
+				}
+			}
+		}
+	}
+	sort.SliceStable(options, func(i, j int) bool {
+		return options[i].score > options[j].score
+	})
+
+	var names []string
+	for _, o := range options {
+		names = mergeNames(names, []string{o.text})
+	}
+	return names, nil
+}
+
+// mergeNames 合并型号列表, 去重去空, 最多 maxSimilarNames 个
+func mergeNames(names []string, others []string) []string {
+	for _, name := range others {
+		if len(names) >= maxSimilarNames {
+			break
+		}
+		if len(name) == 0 || utility.ContainsString(names, name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,82 @@
+package products
+
+import (
+	"context"
+	"easyms-es/easyes"
+	"easyms-es/model"
+	"easyms-es/service/models"
+	"easyms-es/utility"
+	"encoding/json"
+	"log"
+	"strings"
+)
+
+// Search 产品搜索, 型号搜索无结果时自动重新搜索
+func Search(ctx context.Context, param model.ProductSearchParam) (models.SearchProductResult, error) {
+	var result models.SearchProductResult
+	result.From, result.Size = pageParam(param.From, param.Size)
+
+	query, err := BuildSearchQuery(param)
+	if err != nil {
+		return result, err
+	}
+
+	res, err := ProductStore.Search(ctx, query)
+	if err != nil {
+		return result, err
+	}
+
+	err = ResponseToProducts(*res, &result)
+	if err != nil {
+		return result, err
+	}
+
+	// 首页无结果时尝试重新搜索, 失败时只记录日志, 返回原始的空结果
+	if result.Total == 0 && result.From == 0 && !param.IsReSearch {
+		if err := reSearch(ctx, param, &result); err != nil {
+			log.Printf("reSearch %q failed: %v", reSearchPartNo(param), err)
+		}
+	}
+
+	return result, nil
+}
+
+// ResponseToProducts 搜索结果转换, 型号高亮按照原始型号重新标记
+func ResponseToProducts(rep easyes.SearchResponse, result *models.SearchProductResult) error {
+	result.Total = int32(rep.Hits.Total.Value)
+	result.Above = rep.Hits.Total.Relation == "gte"
+
+	for _, hit := range rep.Hits.Hits {
+		var p model.Product
+
+		if err := json.Unmarshal(hit.Source, &p); err != nil {
+			return err
+		}
+		result.Results = append(result.Results, p)
+
+		highlights, ok := hit.Highlight["StandProductName"]
+		if !ok || len(highlights) == 0 {
+			continue
+		}
+		em := utility.ExtractHighlightedStr(highlights[0])
+		productName := GetProductName(p)
+		if len(em) == 0 || len(productName) == 0 {
+			continue
+		}
+		if result.Highlights == nil {
+			result.Highlights = make(map[int]string)
+		}
+		result.Highlights[p.PID] = utility.HighlightProductialMatch(strings.ToUpper(productName), em)
+	}
+
+	return nil
+}
+
+// GetProductName 从产品扩展字段中获取原始型号 {ProductName},{Brand},{Description},{Canonical}
+func GetProductName(p model.Product) string {
+	productExt := utility.SplitStrToMap(p.ProductExt)
+	if len(productExt[0]) > 0 {
+		return productExt[0][0]
+	}
+	return ""
+}
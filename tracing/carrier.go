@@ -0,0 +1,43 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataCarrier 以 gRPC metadata 作为 trace context 的载体
+type MetadataCarrier metadata.MD
+
+// Get 读取第一个值
+func (c MetadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Set 设置值
+func (c MetadataCarrier) Set(key string, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+// Keys 全部键
+func (c MetadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Extract 从请求的 metadata 读取上游的 trace context (traceparent/tracestate/baggage)
+func Extract(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, MetadataCarrier(md))
+}
@@ -0,0 +1,77 @@
+package tracing
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const gormSpanKey = "tracing:span"
+
+// GormPlugin 为 gorm 的每次SQL执行创建span, 父span取自 db.WithContext 传入的上下文
+// statement 只记录带占位符的SQL, 不记录参数值
+type GormPlugin struct{}
+
+// Name 插件名称
+func (GormPlugin) Name() string {
+	return "tracing"
+}
+
+// Initialize 在 gorm 各类操作的前后注册回调
+func (p GormPlugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	return errors.Join(
+		callback.Create().Before("gorm:create").Register("tracing:before_create", p.before("create")),
+		callback.Create().After("gorm:create").Register("tracing:after_create", p.after),
+		callback.Query().Before("gorm:query").Register("tracing:before_query", p.before("query")),
+		callback.Query().After("gorm:query").Register("tracing:after_query", p.after),
+		callback.Update().Before("gorm:update").Register("tracing:before_update", p.before("update")),
+		callback.Update().After("gorm:update").Register("tracing:after_update", p.after),
+		callback.Delete().Before("gorm:delete").Register("tracing:before_delete", p.before("delete")),
+		callback.Delete().After("gorm:delete").Register("tracing:after_delete", p.after),
+		callback.Row().Before("gorm:row").Register("tracing:before_row", p.before("row")),
+		callback.Row().After("gorm:row").Register("tracing:after_row", p.after),
+		callback.Raw().Before("gorm:raw").Register("tracing:before_raw", p.before("raw")),
+		callback.Raw().After("gorm:raw").Register("tracing:after_raw", p.after),
+	)
+}
+
+// before 开始span并替换语句的上下文
+func (GormPlugin) before(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		ctx, span := otel.Tracer("easyms-es/db").Start(db.Statement.Context, "mssql "+operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemMSSQL, semconv.DBOperation(operation)),
+		)
+		db.Statement.Context = ctx
+		db.InstanceSet(gormSpanKey, span)
+	}
+}
+
+// after 记录SQL、表名、影响行数及错误, 未找到记录不视为错误
+func (GormPlugin) after(db *gorm.DB) {
+	value, ok := db.InstanceGet(gormSpanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	span.SetAttributes(
+		semconv.DBStatement(db.Statement.SQL.String()),
+		semconv.DBSQLTable(db.Statement.Table),
+		attribute.Int64("db.rows_affected", db.Statement.RowsAffected),
+	)
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
@@ -0,0 +1,106 @@
+// Package tracing OpenTelemetry 链路追踪, 支持 otlp、stdout 及 file 导出, 使用 W3C Trace Context 传播
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// 导出方式
+const (
+	ExporterOTLP   = "otlp"   // OTLP gRPC, 如 Jaeger、Tempo 或 OpenTelemetry Collector
+	ExporterStdout = "stdout" // 输出到标准输出, 用于本地调试
+	ExporterFile   = "file"   // 以JSON写入文件
+)
+
+// Config 链路追踪配置, Exporter 为空时不导出, 仍传播调用方的 trace context
+type Config struct {
+	Exporter    string  `mapstructure:"exporter"`
+	Endpoint    string  `mapstructure:"endpoint"` // otlp 地址, 如 localhost:4317, 为空时使用 OTEL_EXPORTER_OTLP_ENDPOINT
+	Insecure    bool    `mapstructure:"insecure"` // otlp 不使用TLS
+	File        string  `mapstructure:"file"`
+	ServiceName string  `mapstructure:"servicename"`
+	SampleRatio float64 `mapstructure:"sampleratio"` // 根span的采样比例, 0 或大于1时全部采样, 有上游时跟随上游的采样结果
+}
+
+// Init 初始化全局 TracerProvider 及传播器, 返回停机时调用的函数, 用于导出剩余的span
+func Init(ctx context.Context, c Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if len(c.Exporter) == 0 {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, closeFile, err := newExporter(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(c.ServiceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	ratio := c.SampleRatio
+	if ratio <= 0 || ratio > 1 {
+		ratio = 1
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closeFile != nil {
+			err = errors.Join(err, closeFile())
+		}
+		return err
+	}, nil
+}
+
+// newExporter 按配置创建导出器, file 导出时同时返回关闭文件的函数
+func newExporter(ctx context.Context, c Config) (sdktrace.SpanExporter, func() error, error) {
+	switch c.Exporter {
+	case ExporterOTLP:
+		var opts []otlptracegrpc.Option
+		if len(c.Endpoint) > 0 {
+			opts = append(opts, otlptracegrpc.WithEndpoint(c.Endpoint))
+		}
+		if c.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err := otlptracegrpc.New(ctx, opts...)
+		return exporter, nil, err
+	case ExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+		return exporter, nil, err
+	case ExporterFile:
+		if len(c.File) == 0 {
+			return nil, nil, errors.New("tracing: file is required for file exporter")
+		}
+		f, err := os.OpenFile(c.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, nil, err
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			_ = f.Close()
+			return nil, nil, err
+		}
+		return exporter, f.Close, nil
+	default:
+		return nil, nil, fmt.Errorf("tracing: unknown exporter %s", c.Exporter)
+	}
+}
@@ -0,0 +1,48 @@
+package utility
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ParseDecimal 解析十进制字符串为有理数, 避免浮点误差
+func ParseDecimal(str string) (*big.Rat, error) {
+	str = strings.TrimSpace(str)
+	if len(str) == 0 {
+		return nil, fmt.Errorf("decimal is empty")
+	}
+	r, ok := new(big.Rat).SetString(str)
+	if !ok {
+		return nil, fmt.Errorf("decimal is invalid: %s", str)
+	}
+	return r, nil
+}
+
+// FormatDecimal 按指定小数位数四舍五入(远离零)格式化有理数
+func FormatDecimal(r *big.Rat, scale int) string {
+	if scale < 0 {
+		scale = 0
+	}
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+
+	// 放大后取整: (|num| * 10^scale * 2 + den) / (den * 2)
+	num := new(big.Int).Abs(r.Num())
+	num.Mul(num, pow)
+	num.Mul(num, big.NewInt(2))
+	num.Add(num, r.Denom())
+	den := new(big.Int).Mul(r.Denom(), big.NewInt(2))
+	num.Quo(num, den)
+
+	digits := num.String()
+	if scale > 0 {
+		if len(digits) <= scale {
+			digits = strings.Repeat("0", scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	}
+	if r.Sign() < 0 && strings.Trim(digits, "0.") != "" {
+		digits = "-" + digits
+	}
+	return digits
+}
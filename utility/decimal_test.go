@@ -0,0 +1,78 @@
+package utility
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestFormatDecimal(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		scale int
+		want  string
+	}{
+		{name: "integer", value: "12", scale: 2, want: "12.00"},
+		{name: "pad fraction", value: "1.5", scale: 4, want: "1.5000"},
+		{name: "round half up", value: "0.125", scale: 2, want: "0.13"},
+		{name: "round down", value: "0.1249", scale: 2, want: "0.12"},
+		{name: "carry into integer", value: "9.9995", scale: 3, want: "10.000"},
+		{name: "less than one", value: "0.0042", scale: 4, want: "0.0042"},
+		{name: "leading zeros", value: "0.00005", scale: 4, want: "0.0001"},
+		{name: "scale 0 round half up", value: "2.5", scale: 0, want: "3"},
+		{name: "scale 0 round down", value: "2.49", scale: 0, want: "2"},
+		{name: "negative scale as 0", value: "2.5", scale: -1, want: "3"},
+		{name: "negative", value: "-1.005", scale: 2, want: "-1.01"},
+		{name: "negative round half away from zero", value: "-2.5", scale: 0, want: "-3"},
+		{name: "negative rounds to zero", value: "-0.004", scale: 2, want: "0.00"},
+		{name: "zero", value: "0", scale: 2, want: "0.00"},
+		{name: "repeating fraction", value: "1/3", scale: 4, want: "0.3333"},
+		{name: "repeating fraction round up", value: "2/3", scale: 4, want: "0.6667"},
+		{name: "large quantity", value: "123456789.123456789", scale: 4, want: "123456789.1235"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, ok := new(big.Rat).SetString(tt.value)
+			if !ok {
+				t.Fatalf("invalid value %s", tt.value)
+			}
+			if got := FormatDecimal(r, tt.scale); got != tt.want {
+				t.Fatalf("FormatDecimal(%s, %d) = %s, want %s", tt.value, tt.scale, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "decimal", value: "0.1", want: "1/10"},
+		{name: "trims spaces", value: " 7.10 ", want: "71/10"},
+		{name: "negative", value: "-3.25", want: "-13/4"},
+		{name: "empty", value: "  ", wantErr: true},
+		{name: "invalid", value: "1,5", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDecimal(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseDecimal(%q) = %v, want error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDecimal(%q) error = %v", tt.value, err)
+			}
+			if got.String() != tt.want {
+				t.Fatalf("ParseDecimal(%q) = %s, want %s", tt.value, got.String(), tt.want)
+			}
+		})
+	}
+}
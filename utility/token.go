@@ -0,0 +1,33 @@
+package utility
+
+import (
+	"bytes"
+	"easyms-es/errno"
+	"encoding/base64"
+	"encoding/json"
+)
+
+// EncodeResumeToken 将排序值编码为续传令牌
+func EncodeResumeToken(values []any) (string, error) {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeResumeToken 解析续传令牌, 数值保持 json.Number 避免精度丢失
+func DecodeResumeToken(token string, size int) ([]any, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errno.InvalidParam("resume token is invalid")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var values []any
+	if err := decoder.Decode(&values); err != nil || len(values) != size {
+		return nil, errno.InvalidParam("resume token is invalid")
+	}
+	return values, nil
+}
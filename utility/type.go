@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 )
 
 // GetFieldIDTag 获取结构体的ID字段
@@ -30,3 +31,33 @@ func GetFieldIDTag(obj any) string {
 	}
 	return ""
 }
+
+// GetEsFieldTags 获取结构体字段的es映射配置, 键为json字段名, 值为es标签解析后的配置 如 type:text,analyzer:easy_brand
+func GetEsFieldTags(obj any) map[string]map[string]string {
+	stype := reflect.TypeOf(obj)
+	if stype.Kind() == reflect.Ptr {
+		stype = stype.Elem()
+	}
+
+	fields := make(map[string]map[string]string)
+	for i := 0; i < stype.NumField(); i++ {
+		fieldType := stype.Field(i)
+		esTag := fieldType.Tag.Get("es")
+		if esTag == "" {
+			continue
+		}
+		name := strings.Split(fieldType.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			name = fieldType.Name
+		}
+		options := make(map[string]string)
+		for _, option := range strings.Split(esTag, ",") {
+			kv := strings.SplitN(option, ":", 2)
+			if len(kv) == 2 {
+				options[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+			}
+		}
+		fields[name] = options
+	}
+	return fields
+}